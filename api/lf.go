@@ -44,6 +44,12 @@ const (
 	GetBlockParamsFormatMsgpack GetBlockParamsFormat = "msgpack"
 )
 
+// Defines values for PendingTransactionInformationParamsFormat.
+const (
+	PendingTransactionInformationParamsFormatJson    PendingTransactionInformationParamsFormat = "json"
+	PendingTransactionInformationParamsFormatMsgpack PendingTransactionInformationParamsFormat = "msgpack"
+)
+
 // Account Account information at a given round.
 //
 // Definition:
@@ -592,6 +598,15 @@ type GenerateParticipationKeysParams struct {
 	Last int `form:"last" json:"last"`
 }
 
+// PendingTransactionInformationParams defines parameters for PendingTransactionInformation.
+type PendingTransactionInformationParams struct {
+	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
+	Format *PendingTransactionInformationParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// PendingTransactionInformationParamsFormat defines parameters for PendingTransactionInformation.
+type PendingTransactionInformationParamsFormat string
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -683,6 +698,9 @@ type ClientInterface interface {
 	// StartCatchup request
 	StartCatchup(ctx context.Context, catchpoint string, params *StartCatchupParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetSupply request
+	GetSupply(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetParticipationKeys request
 	GetParticipationKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -707,6 +725,15 @@ type ClientInterface interface {
 	// WaitForBlock request
 	WaitForBlock(ctx context.Context, round int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// RawTransactionWithBody request with any body
+	RawTransactionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TransactionParams request
+	TransactionParams(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PendingTransactionInformation request
+	PendingTransactionInformation(ctx context.Context, txid string, params *PendingTransactionInformationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetVersion request
 	GetVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
@@ -783,6 +810,18 @@ func (c *Algod) StartCatchup(ctx context.Context, catchpoint string, params *Sta
 	return c.Client.Do(req)
 }
 
+func (c *Algod) GetSupply(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSupplyRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Algod) GetParticipationKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetParticipationKeysRequest(c.Server)
 	if err != nil {
@@ -879,6 +918,42 @@ func (c *Algod) WaitForBlock(ctx context.Context, round int, reqEditors ...Reque
 	return c.Client.Do(req)
 }
 
+func (c *Algod) RawTransactionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRawTransactionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Algod) TransactionParams(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransactionParamsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Algod) PendingTransactionInformation(ctx context.Context, txid string, params *PendingTransactionInformationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPendingTransactionInformationRequest(c.Server, txid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Algod) GetVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetVersionRequest(c.Server)
 	if err != nil {
@@ -1163,6 +1238,33 @@ func NewStartCatchupRequest(server string, catchpoint string, params *StartCatch
 	return req, nil
 }
 
+// NewGetSupplyRequest generates requests for GetSupply
+func NewGetSupplyRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/ledger/supply")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetParticipationKeysRequest generates requests for GetParticipationKeys
 func NewGetParticipationKeysRequest(server string) (*http.Request, error) {
 	var err error
@@ -1464,6 +1566,118 @@ func NewWaitForBlockRequest(server string, round int) (*http.Request, error) {
 	return req, nil
 }
 
+// NewRawTransactionRequestWithBody generates requests for RawTransaction with any type of body
+func NewRawTransactionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/transactions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTransactionParamsRequest generates requests for TransactionParams
+func NewTransactionParamsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/transactions/params")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPendingTransactionInformationRequest generates requests for PendingTransactionInformation
+func NewPendingTransactionInformationRequest(server string, txid string, params *PendingTransactionInformationParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "txid", runtime.ParamLocationPath, txid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/transactions/pending/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetVersionRequest generates requests for GetVersion
 func NewGetVersionRequest(server string) (*http.Request, error) {
 	var err error
@@ -1552,6 +1766,9 @@ type ClientWithResponsesInterface interface {
 	// StartCatchupWithResponse request
 	StartCatchupWithResponse(ctx context.Context, catchpoint string, params *StartCatchupParams, reqEditors ...RequestEditorFn) (*StartCatchupResponse, error)
 
+	// GetSupplyWithResponse request
+	GetSupplyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSupplyResponse, error)
+
 	// GetParticipationKeysWithResponse request
 	GetParticipationKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetParticipationKeysResponse, error)
 
@@ -1576,6 +1793,15 @@ type ClientWithResponsesInterface interface {
 	// WaitForBlockWithResponse request
 	WaitForBlockWithResponse(ctx context.Context, round int, reqEditors ...RequestEditorFn) (*WaitForBlockResponse, error)
 
+	// RawTransactionWithBodyWithResponse request with any body
+	RawTransactionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RawTransactionResponse, error)
+
+	// TransactionParamsWithResponse request
+	TransactionParamsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TransactionParamsResponse, error)
+
+	// PendingTransactionInformationWithResponse request
+	PendingTransactionInformationWithResponse(ctx context.Context, txid string, params *PendingTransactionInformationParams, reqEditors ...RequestEditorFn) (*PendingTransactionInformationResponse, error)
+
 	// GetVersionWithResponse request
 	GetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionResponse, error)
 }
@@ -1741,6 +1967,38 @@ func (r StartCatchupResponse) StatusCode() int {
 	return 0
 }
 
+type GetSupplyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// CurrentRound Round
+		CurrentRound int `json:"current_round"`
+
+		// OnlineMoney OnlineMoney
+		OnlineMoney int `json:"online-money"`
+
+		// TotalMoney TotalMoney
+		TotalMoney int `json:"total-money"`
+	}
+	JSON401 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSupplyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSupplyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetParticipationKeysResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -2106,6 +2364,110 @@ func (r WaitForBlockResponse) StatusCode() int {
 	return 0
 }
 
+type RawTransactionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// TxId encoding of the transaction hash.
+		TxId string `json:"txId"`
+	}
+	JSON400 *ErrorResponse
+	JSON401 *ErrorResponse
+	JSON500 *ErrorResponse
+	JSON503 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RawTransactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RawTransactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TransactionParamsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// ConsensusVersion ConsensusVersion indicates the consensus protocol version
+		// as of LastRound.
+		ConsensusVersion string `json:"consensus-version"`
+
+		// Fee Fee is the suggested transaction fee
+		// Fee is in units of micro-Algos per byte.
+		// Fee may fall to zero but transactions must still have a fee of
+		// at least MinTxnFee for the current network protocol.
+		Fee int `json:"fee"`
+
+		// GenesisHash GenesisHash is the hash of the genesis block.
+		GenesisHash []byte `json:"genesis-hash"`
+
+		// GenesisId GenesisID is an ID listed in the genesis block.
+		GenesisId string `json:"genesis-id"`
+
+		// LastRound LastRound indicates the last round seen
+		LastRound int `json:"last-round"`
+
+		// MinFee The minimum transaction fee (not per byte) required for the
+		// txn to validate for the current network protocol.
+		MinFee int `json:"min-fee"`
+	}
+	JSON401 *ErrorResponse
+	JSON500 *ErrorResponse
+	JSON503 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r TransactionParamsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TransactionParamsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PendingTransactionInformationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PendingTransactionResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+	JSON503      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PendingTransactionInformationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PendingTransactionInformationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetVersionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -2182,6 +2544,15 @@ func (c *ClientWithResponses) StartCatchupWithResponse(ctx context.Context, catc
 	return ParseStartCatchupResponse(rsp)
 }
 
+// GetSupplyWithResponse request returning *GetSupplyResponse
+func (c *ClientWithResponses) GetSupplyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSupplyResponse, error) {
+	rsp, err := c.GetSupply(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSupplyResponse(rsp)
+}
+
 // GetParticipationKeysWithResponse request returning *GetParticipationKeysResponse
 func (c *ClientWithResponses) GetParticipationKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetParticipationKeysResponse, error) {
 	rsp, err := c.GetParticipationKeys(ctx, reqEditors...)
@@ -2254,6 +2625,33 @@ func (c *ClientWithResponses) WaitForBlockWithResponse(ctx context.Context, roun
 	return ParseWaitForBlockResponse(rsp)
 }
 
+// RawTransactionWithBodyWithResponse request with arbitrary body returning *RawTransactionResponse
+func (c *ClientWithResponses) RawTransactionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RawTransactionResponse, error) {
+	rsp, err := c.RawTransactionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRawTransactionResponse(rsp)
+}
+
+// TransactionParamsWithResponse request returning *TransactionParamsResponse
+func (c *ClientWithResponses) TransactionParamsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TransactionParamsResponse, error) {
+	rsp, err := c.TransactionParams(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransactionParamsResponse(rsp)
+}
+
+// PendingTransactionInformationWithResponse request returning *PendingTransactionInformationResponse
+func (c *ClientWithResponses) PendingTransactionInformationWithResponse(ctx context.Context, txid string, params *PendingTransactionInformationParams, reqEditors ...RequestEditorFn) (*PendingTransactionInformationResponse, error) {
+	rsp, err := c.PendingTransactionInformation(ctx, txid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePendingTransactionInformationResponse(rsp)
+}
+
 // GetVersionWithResponse request returning *GetVersionResponse
 func (c *ClientWithResponses) GetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionResponse, error) {
 	rsp, err := c.GetVersion(ctx, reqEditors...)
@@ -2556,6 +2954,48 @@ func ParseStartCatchupResponse(rsp *http.Response) (*StartCatchupResponse, error
 	return response, nil
 }
 
+// ParseGetSupplyResponse parses an HTTP response from a GetSupplyWithResponse call
+func ParseGetSupplyResponse(rsp *http.Response) (*GetSupplyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSupplyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// CurrentRound Round
+			CurrentRound int `json:"current_round"`
+
+			// OnlineMoney OnlineMoney
+			OnlineMoney int `json:"online-money"`
+
+			// TotalMoney TotalMoney
+			TotalMoney int `json:"total-money"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetParticipationKeysResponse parses an HTTP response from a GetParticipationKeysWithResponse call
 func ParseGetParticipationKeysResponse(rsp *http.Response) (*GetParticipationKeysResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -3133,6 +3573,194 @@ func ParseWaitForBlockResponse(rsp *http.Response) (*WaitForBlockResponse, error
 	return response, nil
 }
 
+// ParseRawTransactionResponse parses an HTTP response from a RawTransactionWithResponse call
+func ParseRawTransactionResponse(rsp *http.Response) (*RawTransactionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RawTransactionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// TxId encoding of the transaction hash.
+			TxId string `json:"txId"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTransactionParamsResponse parses an HTTP response from a TransactionParamsWithResponse call
+func ParseTransactionParamsResponse(rsp *http.Response) (*TransactionParamsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TransactionParamsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// ConsensusVersion ConsensusVersion indicates the consensus protocol version
+			// as of LastRound.
+			ConsensusVersion string `json:"consensus-version"`
+
+			// Fee Fee is the suggested transaction fee
+			// Fee is in units of micro-Algos per byte.
+			// Fee may fall to zero but transactions must still have a fee of
+			// at least MinTxnFee for the current network protocol.
+			Fee int `json:"fee"`
+
+			// GenesisHash GenesisHash is the hash of the genesis block.
+			GenesisHash []byte `json:"genesis-hash"`
+
+			// GenesisId GenesisID is an ID listed in the genesis block.
+			GenesisId string `json:"genesis-id"`
+
+			// LastRound LastRound indicates the last round seen
+			LastRound int `json:"last-round"`
+
+			// MinFee The minimum transaction fee (not per byte) required for the
+			// txn to validate for the current network protocol.
+			MinFee int `json:"min-fee"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePendingTransactionInformationResponse parses an HTTP response from a PendingTransactionInformationWithResponse call
+func ParsePendingTransactionInformationResponse(rsp *http.Response) (*PendingTransactionInformationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PendingTransactionInformationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PendingTransactionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetVersionResponse parses an HTTP response from a GetVersionWithResponse call
 func ParseGetVersionResponse(rsp *http.Response) (*GetVersionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
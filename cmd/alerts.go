@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/api"
+	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/alerts"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+// alertsExpiryDays is the number of days before key expiry to start alerting.
+var alertsExpiryDays = 7
+
+// alertsStallRounds is the number of rounds without progress before the node is considered stalled.
+var alertsStallRounds = 10
+
+// alertsQuiet is how long an active alert stays silent after it was sent.
+var alertsQuiet = alerts.DefaultQuietPeriod
+
+// alertsWebhooks are the URLs that receive alerts as JSON.
+var alertsWebhooks []string
+
+// alertsExec is a local command to run for every alert.
+var alertsExec string
+
+// alertsSMTP configures the email notifier, it is disabled without a server address.
+var alertsSMTP alerts.SMTPNotifier
+
+// alertsSMTPPasswordFile is the path of a file holding the SMTP password,
+// the password is never given on the command line where it shows up in ps and the shell history.
+var alertsSMTPPasswordFile string
+
+var alertsShort = "Watch the node and send participation alerts"
+
+var alertsLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(alertsShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Watches the node and notifies webhooks, email or a local command when:",
+	"",
+	"- a participation key is about to expire",
+	"- an account went offline or was suspended",
+	"- an account is online with a key that is not on this node",
+	"- the node is down or stalled",
+	"- an account lost its incentive eligibility",
+	"",
+	"Active alerts are repeated once every quiet period until they resolve.",
+	fmt.Sprintf("The SMTP password is read from --smtp-password-file or the %s environment variable.", alerts.SMTPPasswordEnv),
+	"",
+	style.Yellow.Render("The command runs until it is interrupted."),
+)
+
+// alertsCmd evaluates the alert rules on every watcher event until interrupted.
+var alertsCmd = cmdutils.WithAlgodFlags(&cobra.Command{
+	Use:          "alerts",
+	Short:        alertsShort,
+	Long:         alertsLong,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var notifiers []alerts.Notifier
+		httpPkg := new(api.HttpPkg)
+		for _, url := range alertsWebhooks {
+			notifiers = append(notifiers, alerts.WebhookNotifier{URL: url})
+		}
+		if alertsSMTP.Addr != "" {
+			if alertsSMTP.From == "" || len(alertsSMTP.To) == 0 {
+				return errors.New("--smtp-from and --smtp-to are required with --smtp")
+			}
+			password, err := alerts.ResolveSMTPPassword(alertsSMTPPasswordFile)
+			if err != nil {
+				return err
+			}
+			alertsSMTP.Password = password
+			notifiers = append(notifiers, alertsSMTP)
+		}
+		if alertsExec != "" {
+			notifiers = append(notifiers, alerts.CommandNotifier{Command: alertsExec})
+		}
+		if len(notifiers) == 0 {
+			return errors.New("at least one of --webhook, --smtp or --exec is required")
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		t := new(system.Clock)
+
+		client, dataDir, err := cmdutils.GetClient(algodData)
+		if err != nil {
+			return err
+		}
+
+		state, stateResponse, err := algod.NewStateModel(ctx, client, httpPkg, IncentivesDisabled, cmd.Root().Version, dataDir)
+		cmdutils.WithInvalidResponsesExplanations(err, stateResponse, cmd.UsageString())
+		if err != nil {
+			return err
+		}
+		state.WatchAccounts = cmdutils.WatchAddresses()
+
+		manager := alerts.Manager{
+			Rules:       alerts.DefaultRules(time.Hour*24*time.Duration(alertsExpiryDays), alertsStallRounds),
+			Notifiers:   notifiers,
+			QuietPeriod: alertsQuiet,
+		}
+		evaluate := func(state *algod.StateModel) {
+			sent, err := manager.Evaluate(ctx, state, t)
+			for _, alert := range sent {
+				log.Warn(alert.Message, "rule", alert.Rule, "severity", alert.Severity)
+			}
+			if err != nil {
+				log.Error(err)
+			}
+		}
+		evaluate(state)
+
+		events, unsubscribe := state.Subscribe()
+		defer unsubscribe()
+		stopRecording, err := cmdutils.RecordHistory(state, cmdutils.NodeName(dataDir))
+		if err != nil {
+			return err
+		}
+		defer stopRecording()
+		defer cmdutils.TrackRoundTime(state)()
+		go func() {
+			_ = state.Watch(ctx, t)
+		}()
+
+		log.Info(style.Green.Render("Watching the node for alerts"))
+		// The TickEvent of the watcher checks the stalled rule while no round arrives
+		for event := range events {
+			evaluate(event.State)
+		}
+		return nil
+	},
+}, &algodData)
+
+func init() {
+	alertsCmd.Flags().IntVar(&alertsExpiryDays, "expiry-days", alertsExpiryDays, style.LightBlue("Alert when a participation key expires within this many days"))
+	alertsCmd.Flags().IntVar(&alertsStallRounds, "stall-rounds", alertsStallRounds, style.LightBlue("Alert when the node has not seen a new round for this many rounds"))
+	alertsCmd.Flags().DurationVar(&alertsQuiet, "quiet", alertsQuiet, style.LightBlue("How long to wait before repeating an active alert"))
+	alertsCmd.Flags().StringArrayVar(&alertsWebhooks, "webhook", nil, style.LightBlue("URL to post alerts to as JSON, can be repeated"))
+	alertsCmd.Flags().StringVar(&alertsExec, "exec", "", style.LightBlue("Command to run for every alert, receives the alert as JSON on stdin"))
+	alertsCmd.Flags().StringVar(&alertsSMTP.Addr, "smtp", "", style.LightBlue("SMTP server address to email alerts through, like smtp.example.com:587"))
+	alertsCmd.Flags().StringVar(&alertsSMTP.From, "smtp-from", "", style.LightBlue("Sender address for alert emails"))
+	alertsCmd.Flags().StringSliceVar(&alertsSMTP.To, "smtp-to", nil, style.LightBlue("Recipient addresses for alert emails"))
+	alertsCmd.Flags().StringVar(&alertsSMTP.Username, "smtp-username", "", style.LightBlue("SMTP username"))
+	alertsCmd.Flags().StringVar(&alertsSMTPPasswordFile, "smtp-password-file", "", style.LightBlue("Path of a file holding the SMTP password, defaults to the "+alerts.SMTPPasswordEnv+" environment variable"))
+}
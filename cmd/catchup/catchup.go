@@ -41,7 +41,7 @@ var (
 			// Create Clients
 			ctx := context.Background()
 			httpPkg := new(api.HttpPkg)
-			client, err := algod.GetClient(dataDir)
+			client, _, err := utils.GetClient(dataDir)
 			cobra.CheckErr(err)
 
 			// Fetch Status from Node
@@ -2,6 +2,8 @@ package configure
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
@@ -16,7 +18,13 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var enableHybrid bool
+var (
+	enableHybrid bool
+	algodSet     []string
+	algodUnset   []string
+	algodGet     []string
+	algodDryRun  bool
+)
 
 // algodShort provides a brief description of the algod command, emphasizing its role in installing algod files.
 var algodShort = "Configure options for the Algorand daemon."
@@ -30,6 +38,14 @@ var algodLong = lipgloss.JoinVertical(
 	"",
 	style.BoldUnderline("Overview:"),
 	"Modify various configuration options available for the Algorand daemon.",
+	"Without flags, the settings of the config.json are displayed and unknown keys are reported.",
+	"Values are validated before they are written, other keys of the config.json are kept.",
+	"The changes are displayed before the config.json is written, the previous file is kept in the configuration history.",
+	"",
+	style.BoldUnderline("Example:"),
+	"  nodekit configure algod --set GossipFanout=8 --set Archival=false",
+	"  nodekit configure algod --unset EnableFollowMode --dry-run",
+	"  nodekit configure algod --get CatchupParallelBlocks",
 )
 
 // TODO: Check if we should enforce sudo for this.
@@ -45,94 +61,180 @@ var algodCmd = cmdutils.WithAlgodFlags(&cobra.Command{
 		}
 
 		// Current Node Configuration
-		currentConfig, _ := utils.GetConfigFromDataDir(dataDir)
-
-		// OR (`||`) additional flags for `hasFlags` when adding something new.
-		hasHybrid := cmd.Flags().Lookup("hybrid").Changed
-		hasFlags := hasHybrid
-
-		restartRequired := false
+		currentConfig, warnings, err := utils.ReadConfigFromDataDir(dataDir)
+		if err != nil {
+			log.Fatalf("Invalid config.json: %s", err)
+		}
+		for _, warning := range warnings {
+			log.Warn(warning)
+		}
+		currentFile, err := utils.GetConfigFileFromDataDir(dataDir)
+		if err != nil {
+			log.Fatal(err)
+		}
 
-		// Are we doing something? If not, just display the current configuration.
-		if hasFlags {
-			newConfig := &config.Config{
-				// EnableP2PHybridMode: currentConfig.EnableP2PHybridMode,
+		for _, key := range algodGet {
+			value, ok, err := config.Get(currentFile, key)
+			if err != nil {
+				return err
 			}
-
-			if hasHybrid {
-				newConfig.EnableP2PHybridMode = &enableHybrid
+			if !ok {
+				value = "not set, algod uses its default"
 			}
+			fmt.Printf("%s: %s\n", key, value)
+		}
 
-			mergedConfig := config.MergeAlgodConfigs(*currentConfig, *newConfig)
-			if currentConfig.IsEqual(mergedConfig) {
-				log.Debug("Configuration up to date, nothing to do")
-			} else {
-				err := utils.WriteConfigToDataDir(dataDir, &mergedConfig)
-				if err != nil {
-					log.Warnf("%s", err)
-					log.Fatalf("%s", explanations.AlgorandPermissionErrorMsg)
-				}
-				restartRequired = true
+		hasHybrid := cmd.Flags().Lookup("hybrid").Changed
+		hasFlags := hasHybrid || len(algodSet) > 0 || len(algodUnset) > 0
+		if !hasFlags {
+			if len(algodGet) == 0 {
+				fmt.Println(currentConfigTable(*currentConfig))
 			}
+			return nil
+		}
 
-		} else {
-
-			hybridModeStatus := "Disabled"
-			if currentConfig.EnableP2PHybridMode != nil && *currentConfig.EnableP2PHybridMode {
-				hybridModeStatus = "Enabled"
+		// Collect the changes, every value is parsed with the type of its setting
+		changes := config.Config{}
+		for _, assignment := range algodSet {
+			key, value, ok := strings.Cut(assignment, "=")
+			if !ok {
+				return fmt.Errorf("--set expects Key=Value, got %q", assignment)
 			}
-
-			rows := [][]string{
-				{"EnableP2PHybridMode:", hybridModeStatus},
+			if err := changes.Set(strings.TrimSpace(key), value); err != nil {
+				return err
 			}
-
-			var (
-				cellStyle      = lipgloss.NewStyle().Padding(0)
-				optionRowStyle = cellStyle.Align(lipgloss.Right)
-				valueRowStyle  = cellStyle.Align(lipgloss.Left)
-			)
-
-			configurationTable := table.New().
-				Border(lipgloss.HiddenBorder()).
-				StyleFunc(func(row, col int) lipgloss.Style {
-					if col == 0 {
-						return optionRowStyle
-					}
-					return valueRowStyle
-				}).
-				Rows(rows...)
-
-			currentConfiguration := lipgloss.JoinVertical(
-				lipgloss.Left,
-				style.BoldUnderline("Current Configuration:"),
-				configurationTable.String(),
-			)
-
-			fmt.Println(currentConfiguration)
-		}
-
-		if restartRequired {
-			log.Debug("Restarting node...")
-			err = algod.Stop()
-			if err != nil {
-				log.Fatal(err)
+		}
+		if hasHybrid {
+			changes.EnableP2PHybridMode = &enableHybrid
+		}
+		mergedConfig := config.MergeAlgodConfigs(*currentConfig, changes)
+		for _, key := range algodUnset {
+			// Misspelled keys can be removed when they are in the file
+			if _, ok, _ := config.Get(currentFile, key); !ok && !config.IsKnownKey(key) {
+				return fmt.Errorf("%s is not a known algod setting", key)
 			}
+			mergedConfig.Unset(key)
+		}
+		if err := mergedConfig.Validate(); err != nil {
+			return fmt.Errorf("invalid configuration:\n%w", err)
+		}
 
-			// Wait 1 second.
-			// Calling stop & start too quickly on Mac (launchctl) appears to
-			// result in a false successfully start. Haven't investigated why.
-			time.Sleep(1 * time.Second)
+		newFile, err := config.Apply(currentFile, changes, algodUnset)
+		if err != nil {
+			return err
+		}
+		diff, err := config.Diff(currentFile, newFile)
+		if err != nil {
+			return err
+		}
+		if len(diff) == 0 {
+			log.Info("Configuration up to date, nothing to do")
+			return nil
+		}
+		fmt.Println(renderDiff(diff))
+		if algodDryRun {
+			log.Info("Dry run, config.json was not changed")
+			return nil
+		}
 
-			err = algod.Start()
-			if err != nil {
-				log.Fatal(err)
-			}
-			log.Debug("Node restarted successfully.")
+		err = utils.WriteConfigFileToDataDir(dataDir, newFile)
+		if err != nil {
+			log.Warnf("%s", err)
+			log.Fatalf("%s", explanations.AlgorandPermissionErrorMsg)
 		}
+		restartNode(dataDir)
 		return nil
 	},
 }, &algodData)
 
+// restartNode restarts a running node to apply its new configuration,
+// a stopped node reads the new configuration when it starts.
+func restartNode(dataDir string) {
+	if !algod.IsRunning(dataDir) {
+		log.Info("The node is not running, the configuration is applied when it starts")
+		return
+	}
+
+	log.Debug("Restarting node...")
+	err := algod.Stop()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Wait 1 second.
+	// Calling stop & start too quickly on Mac (launchctl) appears to
+	// result in a false successfully start. Haven't investigated why.
+	time.Sleep(1 * time.Second)
+
+	err = algod.Start()
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Debug("Node restarted successfully.")
+}
+
+// currentConfigTable renders the settings of the config.json that NodeKit knows about.
+func currentConfigTable(currentConfig config.Config) string {
+	hybridModeStatus := "Disabled"
+	if currentConfig.EnableP2PHybridMode != nil && *currentConfig.EnableP2PHybridMode {
+		hybridModeStatus = "Enabled"
+	}
+
+	rows := [][]string{
+		{"EnableP2PHybridMode:", hybridModeStatus},
+	}
+	values := currentConfig.Values()
+	delete(values, "EnableP2PHybridMode")
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		rows = append(rows, []string{key + ":", fmt.Sprintf("%v", values[key])})
+	}
+
+	var (
+		cellStyle      = lipgloss.NewStyle().Padding(0)
+		optionRowStyle = cellStyle.Align(lipgloss.Right)
+		valueRowStyle  = cellStyle.Align(lipgloss.Left)
+	)
+
+	configurationTable := table.New().
+		Border(lipgloss.HiddenBorder()).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if col == 0 {
+				return optionRowStyle
+			}
+			return valueRowStyle
+		}).
+		Rows(rows...)
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		style.BoldUnderline("Current Configuration:"),
+		configurationTable.String(),
+	)
+}
+
+// renderDiff renders the changes of the config.json, removed values in red and added values in green.
+func renderDiff(changes []config.Change) string {
+	lines := []string{style.BoldUnderline("config.json changes:")}
+	for _, change := range changes {
+		if change.Before != "" {
+			lines = append(lines, style.Red.Render(fmt.Sprintf("- %s: %s", change.Key, change.Before)))
+		}
+		if change.After != "" {
+			lines = append(lines, style.Green.Render(fmt.Sprintf("+ %s: %s", change.Key, change.After)))
+		}
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
 func init() {
 	algodCmd.Flags().BoolVar(&enableHybrid, "hybrid", true, "Enable or Disable P2P Hybrid Mode")
+	algodCmd.Flags().StringArrayVar(&algodSet, "set", nil, style.LightBlue("Set a config.json setting as Key=Value, can be repeated"))
+	algodCmd.Flags().StringArrayVar(&algodUnset, "unset", nil, style.LightBlue("Remove a setting from the config.json to use the algod default, can be repeated"))
+	algodCmd.Flags().StringArrayVar(&algodGet, "get", nil, style.LightBlue("Print the value of a config.json setting, can be repeated"))
+	algodCmd.Flags().BoolVar(&algodDryRun, "dry-run", false, style.LightBlue("Display the changes without writing the config.json"))
 }
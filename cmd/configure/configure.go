@@ -11,6 +11,7 @@ import (
 
 	"github.com/algorandfoundation/nodekit/cmd/utils/explanations"
 	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/backup"
 	"github.com/algorandfoundation/nodekit/internal/algod/utils"
 	"github.com/algorandfoundation/nodekit/ui/style"
 	"github.com/charmbracelet/lipgloss"
@@ -45,6 +46,9 @@ func init() {
 	Cmd.AddCommand(serviceCmd)
 	Cmd.AddCommand(telemetryCmd)
 	Cmd.AddCommand(algodCmd)
+	Cmd.AddCommand(profileCmd)
+	Cmd.AddCommand(historyCmd)
+	Cmd.AddCommand(rollbackCmd)
 }
 
 const RunningErrorMsg = "algorand is currently running. Please stop the node with *node stop* before configuring"
@@ -192,6 +196,16 @@ func configureNode() error {
 func editAlgorandServiceFile(dataDirectoryPath string) {
 	switch runtime.GOOS {
 	case "linux":
+		// A user service has no override, its unit is rewritten
+		if algod.IsUserService() {
+			err := algod.UpdateService(dataDirectoryPath)
+			if err != nil {
+				fmt.Printf("Failed to update the user service: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Println("Algorand user service file updated successfully.")
+			return
+		}
 		editSystemdAlgorandServiceFile(dataDirectoryPath)
 	case "darwin":
 		editLaunchdAlgorandServiceFile(dataDirectoryPath)
@@ -326,6 +340,13 @@ ExecStart={{.AlgodPath}} -d {{.DataDirectoryPath}}`
 		os.Exit(1)
 	}
 
+	// Save the previous override in the history of the data directory
+	_, err = backup.Snapshot(dataDirectoryPath, overrideFilePath)
+	if err != nil {
+		fmt.Printf("Failed to save the previous override file: %v\n", err)
+		os.Exit(1)
+	}
+
 	// Write the override content to the file
 	err = os.WriteFile(overrideFilePath, overrideContent.Bytes(), 0o644)
 	if err != nil {
@@ -0,0 +1,203 @@
+package configure
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/cmd/utils/explanations"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/backup"
+	"github.com/algorandfoundation/nodekit/internal/algod/config"
+	"github.com/algorandfoundation/nodekit/internal/algod/utils"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+var rollbackRestart bool
+
+// historyShort provides a brief description of the history command.
+var historyShort = "List the previous versions of the configuration files."
+
+// historyLong provides a detailed description of the history command.
+var historyLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(historyShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"NodeKit saves the config.json, logging.config and the systemd service override before changing them.",
+	fmt.Sprintf("The versions are kept in the %s directory of the data directory, the latest %d are kept.", backup.Dirname, backup.MaxVersions),
+	"Each version holds the files as they were before the listed command changed them.",
+	"Restore a version with *nodekit configure rollback <id>*.",
+)
+
+// historyCmd is a Cobra command listing the versions of the configuration files
+var historyCmd = cmdutils.WithAlgodFlags(&cobra.Command{
+	Use:          "history",
+	Short:        historyShort,
+	Long:         historyLong,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataDir, err := algod.GetDataDir(algodData)
+		if err != nil {
+			return err
+		}
+		versions, err := backup.List(dataDir)
+		if err != nil {
+			return err
+		}
+		if len(versions) == 0 {
+			log.Info("No changes were made to the configuration files yet")
+			return nil
+		}
+		fmt.Println(versionsTable(versions))
+		return nil
+	},
+}, &algodData)
+
+// rollbackShort provides a brief description of the rollback command.
+var rollbackShort = "Restore a previous version of the configuration files."
+
+// rollbackLong provides a detailed description of the rollback command.
+var rollbackLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(rollbackShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Restores the files of a version listed by *nodekit configure history*, the latest version by default.",
+	"The current files are saved first, so a rollback can be undone with another rollback.",
+	"",
+	style.BoldUnderline("Example:"),
+	"  nodekit configure rollback",
+	"  nodekit configure rollback 12 --restart",
+)
+
+// rollbackCmd is a Cobra command restoring a version of the configuration files
+var rollbackCmd = cmdutils.WithAlgodFlags(&cobra.Command{
+	Use:          "rollback [id]",
+	Short:        rollbackShort,
+	Long:         rollbackLong,
+	Args:         cobra.MaximumNArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataDir, err := algod.GetDataDir(algodData)
+		if err != nil {
+			return err
+		}
+
+		var id int
+		if len(args) == 1 {
+			id, err = strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("%q is not a version id", args[0])
+			}
+		} else {
+			versions, err := backup.List(dataDir)
+			if err != nil {
+				return err
+			}
+			if len(versions) == 0 {
+				return fmt.Errorf("no previous version in %s", backup.Dir(dataDir))
+			}
+			id = versions[len(versions)-1].ID
+		}
+
+		version, err := backup.Get(dataDir, id)
+		if err != nil {
+			return err
+		}
+		configFile := filepath.Join(dataDir, "config.json")
+		for _, file := range version.Files {
+			if file.Path == configFile {
+				if err := printConfigRollback(dataDir, version, configFile); err != nil {
+					return err
+				}
+			}
+		}
+
+		version, err = backup.Restore(dataDir, id)
+		if err != nil {
+			log.Warnf("%s", err)
+			log.Fatalf("%s", explanations.AlgorandPermissionErrorMsg)
+		}
+		reload := false
+		for _, file := range version.Files {
+			if file.Exists {
+				log.Infof("Restored %s", file.Path)
+			} else {
+				log.Infof("Removed %s", file.Path)
+			}
+			// Service files are the only files outside of the data directory
+			reload = reload || filepath.Dir(file.Path) != filepath.Clean(dataDir)
+		}
+		if reload {
+			if err := algod.ReloadService(); err != nil {
+				return err
+			}
+		}
+
+		if rollbackRestart {
+			restartNode(dataDir)
+		} else {
+			log.Info("Restart the node with *nodekit stop* and *nodekit start* to apply the configuration")
+		}
+		return nil
+	},
+}, &algodData)
+
+// printConfigRollback prints the changes of the config.json made by restoring a version.
+func printConfigRollback(dataDir string, version backup.Version, configFile string) error {
+	current, err := utils.GetConfigFileFromDataDir(dataDir)
+	if err != nil {
+		return err
+	}
+	previous, err := version.Content(dataDir, configFile)
+	if err != nil {
+		return err
+	}
+	diff, err := config.Diff(current, previous)
+	if err != nil {
+		return err
+	}
+	if len(diff) > 0 {
+		fmt.Println(renderDiff(diff))
+	}
+	return nil
+}
+
+// versionsTable renders the versions of the configuration files, the latest first.
+func versionsTable(versions []backup.Version) string {
+	rows := make([][]string, 0, len(versions))
+	for i := len(versions) - 1; i >= 0; i-- {
+		v := versions[i]
+		files := make([]string, 0, len(v.Files))
+		for _, file := range v.Files {
+			files = append(files, filepath.Base(file.Path))
+		}
+		rows = append(rows, []string{
+			strconv.Itoa(v.ID),
+			v.Time.Local().Format(time.DateTime),
+			strings.Join(files, ", "),
+			v.Command,
+		})
+	}
+	return table.New().
+		Border(lipgloss.NormalBorder()).
+		Headers("Id", "Time", "Files", "Command").
+		Rows(rows...).
+		String()
+}
+
+func init() {
+	rollbackCmd.Flags().BoolVar(&rollbackRestart, "restart", false, style.LightBlue("Restart the node after restoring the files"))
+}
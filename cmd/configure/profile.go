@@ -0,0 +1,143 @@
+package configure
+
+import (
+	"fmt"
+	"time"
+
+	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/cmd/utils/explanations"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/config"
+	"github.com/algorandfoundation/nodekit/internal/algod/utils"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+var profileDryRun bool
+
+// profileShort provides a brief description of the profile command.
+var profileShort = "Configure the Algorand daemon for a node role."
+
+// profileLong provides a detailed description of the profile command and the built-in profiles.
+var profileLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(profileShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Applies the config.json settings of a built-in profile, other keys of the config.json are kept.",
+	"Every profile sets the same keys, so switching profiles leaves no setting of the previous role behind.",
+	"Without a name, the profiles are listed with the one applied to the node.",
+	"",
+	style.BoldUnderline("Example:"),
+	"  nodekit configure profile participation",
+	"  nodekit configure profile hybrid-relay --dry-run",
+)
+
+// profileCmd is a Cobra command applying a built-in profile to the config.json
+var profileCmd = cmdutils.WithAlgodFlags(&cobra.Command{
+	Use:       "profile [name]",
+	Short:     profileShort,
+	Long:      profileLong,
+	Args:      cobra.MaximumNArgs(1),
+	ValidArgs: config.ProfileNames(),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataDir, err := algod.GetDataDir(algodData)
+		if err != nil {
+			log.Fatal(err)
+		}
+		applied, err := utils.GetProfileFromDataDir(dataDir)
+		if err != nil {
+			return err
+		}
+		currentConfig, err := utils.GetConfigFromDataDir(dataDir)
+		if err != nil {
+			log.Fatalf("Invalid config.json: %s", err)
+		}
+
+		if len(args) == 0 {
+			fmt.Println(profilesTable(config.DescribeProfile(applied, *currentConfig)))
+			return nil
+		}
+
+		profile, err := config.GetProfile(args[0])
+		if err != nil {
+			return err
+		}
+		currentFile, err := utils.GetConfigFileFromDataDir(dataDir)
+		if err != nil {
+			log.Fatal(err)
+		}
+		newFile, err := config.Apply(currentFile, profile.Config, nil)
+		if err != nil {
+			return err
+		}
+		diff, err := config.Diff(currentFile, newFile)
+		if err != nil {
+			return err
+		}
+		if len(diff) > 0 {
+			fmt.Println(renderDiff(diff))
+		} else {
+			log.Infof("config.json already has the settings of the %s profile", profile.Name)
+		}
+		if profileDryRun {
+			log.Info("Dry run, config.json was not changed")
+			return nil
+		}
+
+		if len(diff) > 0 {
+			err = utils.WriteConfigToDataDir(dataDir, &profile.Config)
+			if err != nil {
+				log.Warnf("%s", err)
+				log.Fatalf("%s", explanations.AlgorandPermissionErrorMsg)
+			}
+		}
+		err = utils.WriteProfileToDataDir(dataDir, config.AppliedProfile{Name: profile.Name, AppliedAt: time.Now()})
+		if err != nil {
+			log.Warnf("%s", err)
+			log.Fatalf("%s", explanations.AlgorandPermissionErrorMsg)
+		}
+		log.Infof("Applied the %s profile", profile.Name)
+
+		if len(diff) > 0 {
+			restartNode(dataDir)
+		}
+		return nil
+	},
+}, &algodData)
+
+// profilesTable renders the built-in profiles, marking the applied one.
+func profilesTable(applied string) string {
+	rows := make([][]string, 0, len(config.Profiles))
+	for _, profile := range config.Profiles {
+		name := profile.Name
+		if applied == profile.Name {
+			name = style.Green.Render(name + " (applied)")
+		} else if applied == profile.Name+" (modified)" {
+			name = style.Yellow.Render(name + " (modified)")
+		}
+		rows = append(rows, []string{name, profile.Description})
+	}
+
+	profiles := table.New().
+		Border(lipgloss.HiddenBorder()).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			return lipgloss.NewStyle().PaddingRight(2)
+		}).
+		Rows(rows...)
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		style.BoldUnderline("Profiles:"),
+		profiles.String(),
+	)
+}
+
+func init() {
+	profileCmd.Flags().BoolVar(&profileDryRun, "dry-run", false, style.LightBlue("Display the changes without writing the config.json"))
+}
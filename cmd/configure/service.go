@@ -26,11 +26,17 @@ var serviceLong = lipgloss.JoinVertical(
 )
 
 // serviceCmd is a Cobra command for managing Algorand service files, requiring root privileges to ensure proper execution.
+// A user service is managed without root, sudo would resolve the home of root and miss its unit.
 var serviceCmd = &cobra.Command{
-	Use:               "service",
-	Short:             serviceShort,
-	Long:              serviceLong,
-	PersistentPreRunE: utils.IsSudoCmd,
+	Use:   "service",
+	Short: serviceShort,
+	Long:  serviceLong,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if algod.IsUserService() {
+			return nil
+		}
+		return utils.IsSudoCmd(cmd, args)
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// TODO: Combine this with algod.UpdateService and algod.SetNetwork
 		return algod.EnsureService()
@@ -9,6 +9,7 @@ import (
 	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
 	"github.com/algorandfoundation/nodekit/cmd/utils/explanations"
 	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/config"
 	"github.com/algorandfoundation/nodekit/internal/algod/telemetry"
 	"github.com/algorandfoundation/nodekit/internal/algod/utils"
 	"github.com/algorandfoundation/nodekit/internal/system"
@@ -33,6 +34,9 @@ type DebugInfo struct {
 	// IsService indicates whether the Algorand software is configured as a system service on the current operating system.
 	IsService bool `json:"isService"`
 
+	// IsUserService indicates whether the Algorand service runs in the systemd instance of the user instead of the system.
+	IsUserService bool `json:"isUserService"`
+
 	// IsInstalled indicates whether the Algorand software is installed on the system by checking its presence and configuration.
 	IsInstalled bool `json:"isInstalled"`
 
@@ -41,6 +45,9 @@ type DebugInfo struct {
 
 	DataFolder utils.DataFolderConfig `json:"data"`
 
+	// Profile is the role profile applied to the config.json, marked as modified when its settings were changed since.
+	Profile string `json:"profile"`
+
 	// Telemetry holds the configuration settings for telemetry, such as enabling, logging, reporting URI, and user details.
 	Telemetry telemetry.Config `json:"telemetry"`
 }
@@ -94,20 +101,29 @@ var debugCmd = cmdutils.WithAlgodFlags(&cobra.Command{
 			folderDebug.Token = folderDebug.Token[:3] + "..."
 		}
 
+		// Get the applied profile
+		var profile string
+		if applied, _ := utils.GetProfileFromDataDir(dataDir); applied != nil {
+			algodConfig, _ := utils.GetConfigFromDataDir(dataDir)
+			profile = config.DescribeProfile(applied, *algodConfig)
+		}
+
 		var stat unix.Statfs_t
 		unix.Statfs(dataDir, &stat)
 		bytesFree := stat.Bavail * uint64(stat.Bsize)
 		folderDebug.BytesFree = fmt.Sprintf("%d bytes (%d MB)", bytesFree, bytesFree/1024/1024)
 
 		info := DebugInfo{
-			Version:     cmd.Root().Version,
-			InPath:      system.CmdExists("algod"),
-			IsRunning:   algod.IsRunning(dataDir),
-			IsService:   algod.IsService(),
-			IsInstalled: algod.IsInstalled(),
-			Algod:       path,
-			DataFolder:  folderDebug,
-			Telemetry:   *logConfig,
+			Version:       cmd.Root().Version,
+			InPath:        system.CmdExists("algod"),
+			IsRunning:     algod.IsRunning(dataDir),
+			IsService:     algod.IsService(),
+			IsUserService: algod.IsUserService(),
+			IsInstalled:   algod.IsInstalled(),
+			Algod:         path,
+			DataFolder:    folderDebug,
+			Profile:       profile,
+			Telemetry:     *logConfig,
 		}
 		data, err := json.MarshalIndent(info, "", " ")
 		if err != nil {
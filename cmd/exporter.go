@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/api"
+	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/exporter"
+	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+// exporterListen is the address the metrics server listens on.
+var exporterListen = "localhost:9180"
+
+// exporterPath is the HTTP path serving the metrics.
+var exporterPath = "/metrics"
+
+var exporterShort = "Serve node and participation metrics for Prometheus"
+
+var exporterLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(exporterShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Watches the node and exposes the metrics derived by NodeKit, like key expiry,",
+	"participation, incentive eligibility and round times, in the Prometheus text format.",
+	"",
+	style.Yellow.Render("The exporter runs until it is interrupted."),
+)
+
+// exporterCmd serves the StateModel as Prometheus metrics until interrupted.
+var exporterCmd = cmdutils.WithAlgodFlags(&cobra.Command{
+	Use:          "exporter",
+	Short:        exporterShort,
+	Long:         exporterLong,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		httpPkg := new(api.HttpPkg)
+		t := new(system.Clock)
+
+		client, dataDir, err := cmdutils.GetClient(algodData)
+		if err != nil {
+			return err
+		}
+
+		state, stateResponse, err := algod.NewStateModel(ctx, client, httpPkg, IncentivesDisabled, cmd.Root().Version, dataDir)
+		cmdutils.WithInvalidResponsesExplanations(err, stateResponse, cmd.UsageString())
+		if err != nil {
+			return err
+		}
+		state.WatchAccounts = cmdutils.WatchAddresses()
+
+		metrics := exporter.New(t)
+		if err := metrics.Update(state); err != nil {
+			return err
+		}
+
+		// Refresh the snapshot on every change reported by the watcher
+		events, unsubscribe := state.Subscribe()
+		defer unsubscribe()
+		stopRecording, err := cmdutils.RecordHistory(state, cmdutils.NodeName(dataDir))
+		if err != nil {
+			return err
+		}
+		defer stopRecording()
+		defer cmdutils.TrackRoundTime(state)()
+		go func() {
+			_ = state.Watch(ctx, t)
+		}()
+		go func() {
+			for event := range events {
+				if err := metrics.Update(event.State); err != nil {
+					log.Error(err)
+				}
+			}
+		}()
+
+		mux := http.NewServeMux()
+		mux.Handle(exporterPath, metrics)
+		server := &http.Server{
+			Addr:              exporterListen,
+			Handler:           mux,
+			ReadHeaderTimeout: 5 * time.Second,
+		}
+		go func() {
+			<-ctx.Done()
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_ = server.Shutdown(shutdownCtx)
+		}()
+
+		log.Info(style.Green.Render("Serving metrics on http://" + exporterListen + exporterPath))
+		err = server.ListenAndServe()
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	},
+}, &algodData)
+
+func init() {
+	exporterCmd.Flags().StringVarP(&exporterListen, "listen", "l", exporterListen, style.LightBlue("Address to serve the metrics on"))
+	exporterCmd.Flags().StringVar(&exporterPath, "path", exporterPath, style.LightBlue("HTTP path for the metrics"))
+}
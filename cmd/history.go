@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/history"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// historyOutput is the format of the records, one of "table", "json" or "csv".
+	historyOutput = "table"
+
+	// historyFilter selects the records to print
+	historyFilter history.Filter
+
+	// historyTypes are the record types to print, all types when empty
+	historyTypes []string
+
+	// historySince limits the records to the last duration
+	historySince time.Duration
+
+	// historyPrune removes the records older than the duration
+	historyPrune time.Duration
+)
+
+var historyShort = "Query the recorded history of the nodes"
+
+var historyLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(historyShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Prints what NodeKit recorded while watching nodes with --record:",
+	"rounds and round times, sync state changes, account status changes,",
+	"participation key generations and deletions and key registrations.",
+	"",
+	"For example, to find when the node last dropped out of sync:",
+	"",
+	"  nodekit history --type sync --limit 5",
+	"",
+	"Use --output csv to export the records to a spreadsheet.",
+)
+
+// historyCmd prints the records of the history file.
+var historyCmd = &cobra.Command{
+	Use:          "history",
+	Short:        historyShort,
+	Long:         historyLong,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := cmdutils.OpenHistory()
+		if err != nil {
+			return err
+		}
+
+		if historyPrune > 0 {
+			removed, err := store.Prune(time.Now().Add(-historyPrune))
+			if err != nil {
+				return err
+			}
+			log.Info(style.Green.Render(fmt.Sprintf("Removed %d records older than %s", removed, historyPrune)))
+			return nil
+		}
+
+		for _, t := range historyTypes {
+			if !slices.Contains(history.Types, history.RecordType(t)) {
+				return fmt.Errorf("unknown record type %q", t)
+			}
+			historyFilter.Types = append(historyFilter.Types, history.RecordType(t))
+		}
+		if historySince > 0 {
+			historyFilter.Since = time.Now().Add(-historySince)
+		}
+		records, err := store.Query(historyFilter)
+		if err != nil {
+			return err
+		}
+
+		switch historyOutput {
+		case "csv":
+			return history.WriteCSV(cmd.OutOrStdout(), records)
+		case "json":
+			data, err := json.MarshalIndent(records, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(data))
+		case "table":
+			if len(records) == 0 {
+				log.Info("No records found in " + store.Path)
+				return nil
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), historyTable(records))
+		default:
+			return fmt.Errorf("unsupported output format %q, use one of table, json or csv", historyOutput)
+		}
+		return nil
+	},
+}
+
+// historyTable renders the records for humans.
+func historyTable(records []history.Record) string {
+	rows := make([][]string, 0, len(records))
+	for _, r := range records {
+		round := ""
+		if r.Round != 0 {
+			round = strconv.FormatUint(r.Round, 10)
+		}
+		rows = append(rows, []string{
+			r.Time.Local().Format(time.DateTime),
+			r.Node,
+			string(r.Type),
+			round,
+			r.Address,
+			r.Value,
+			r.Message,
+		})
+	}
+	return table.New().
+		Border(lipgloss.NormalBorder()).
+		Headers("Time", "Node", "Type", "Round", "Account", "Value", "Message").
+		Rows(rows...).
+		String()
+}
+
+func init() {
+	var types []string
+	for _, t := range history.Types {
+		types = append(types, string(t))
+	}
+	historyCmd.Flags().StringVarP(&historyOutput, "output", "o", "table", style.LightBlue("Output format: table, json or csv"))
+	historyCmd.Flags().StringSliceVarP(&historyTypes, "type", "t", nil, style.LightBlue("Record types to print: "+strings.Join(types, ", ")))
+	historyCmd.Flags().StringVar(&historyFilter.Node, "node", "", style.LightBlue("Only print the records of the node, its data directory or endpoint"))
+	historyCmd.Flags().StringVarP(&historyFilter.Address, "address", "a", "", style.LightBlue("Only print the records of the account"))
+	historyCmd.Flags().DurationVar(&historySince, "since", 0, style.LightBlue("Only print the records of the last duration, like 24h"))
+	historyCmd.Flags().IntVarP(&historyFilter.Limit, "limit", "l", 0, style.LightBlue("Only print the most recent records"))
+	historyCmd.Flags().DurationVar(&historyPrune, "prune", 0, style.LightBlue("Remove the records older than the duration instead of printing, like 720h"))
+}
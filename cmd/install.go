@@ -18,6 +18,12 @@ const InstallMsg = "Installing Algorand"
 // InstallExistsMsg is a constant string used to indicate that the Algod is already installed on the system.
 const InstallExistsMsg = "algod is already installed"
 
+// installUser installs the node as a service of the systemd instance of the current user.
+var installUser bool
+
+// installLinger keeps the user service running after the user logs out.
+var installLinger bool
+
 var installShort = "Install the node daemon"
 
 var installLong = lipgloss.JoinVertical(
@@ -29,6 +35,13 @@ var installLong = lipgloss.JoinVertical(
 	style.BoldUnderline("Overview:"),
 	"Configures the local package manager and installs the algorand daemon on your local machine",
 	"",
+	"On Linux, --user installs the daemon without root access in ~/node, with its data directory in ~/node/data,",
+	"and runs it as a service of the systemd instance of your user. The start, stop, status and uninstall commands",
+	"manage the user service once it is installed. Add --linger to keep the node running after you log out.",
+	"",
+	style.BoldUnderline("Example:"),
+	"  nodekit install",
+	"  nodekit install --user --linger",
 )
 
 // installCmd is a Cobra command that installs the Algorand daemon on the local machine, ensuring the service is operational.
@@ -40,8 +53,23 @@ var installCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		// TODO: yes flag
 
+		if installLinger && !installUser {
+			log.Fatal("--linger is only supported with --user")
+		}
+
 		// TODO: get expected version
 		log.Info(style.Green.Render(InstallMsg))
+		if installUser {
+			if algod.IsUserService() && !force {
+				log.Fatal(InstallExistsMsg)
+			}
+			err := algod.InstallUser(installLinger)
+			if err != nil {
+				log.Fatal(err)
+			}
+			log.Info(style.Green.Render("Algorand installed successfully 🎉"))
+			return
+		}
 		// Warn user for prompt
 		log.Warn(style.Yellow.Render(explanations.SudoWarningMsg))
 
@@ -75,4 +103,6 @@ var installCmd = &cobra.Command{
 
 func init() {
 	installCmd.Flags().BoolVarP(&force, "force", "f", false, style.Yellow.Render("forcefully install the node"))
+	installCmd.Flags().BoolVar(&installUser, "user", false, style.LightBlue("Install the node as a systemd service of your user, without root access (Linux)"))
+	installCmd.Flags().BoolVar(&installLinger, "linger", false, style.LightBlue("Keep the user service running after you log out"))
 }
@@ -0,0 +1,104 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/participation"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// importAddress is the account the key file is expected to belong to
+	importAddress string
+
+	// importFailover are the data directories of the nodes that also receive the key
+	importFailover []string
+)
+
+// importCmdShort provides a concise description of the "import" command.
+var importCmdShort = "Install a participation key file on the node"
+
+// importCmdLong provides a detailed description for the "import" command.
+var importCmdLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(importCmdShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Uploads a "+participation.FileExtension+" file generated elsewhere, for example with `algokey` on an offline machine,",
+	"and checks the key belongs to the expected account and has not expired.",
+	"Keys that fail the checks are removed from the node again.",
+	"",
+	"Use --failover to install the same key into standby nodes on this machine.",
+	"",
+	style.Yellow.Render("Note: only one node should be running with a registered key, duplicate votes are discarded by the network."),
+)
+
+// importCmd installs a participation key file on the node and any failover nodes.
+var importCmd = utils.WithAlgodFlags(&cobra.Command{
+	Use:          "import <file>",
+	Short:        importCmdShort,
+	Long:         importCmdLong,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if filepath.Ext(args[0]) != participation.FileExtension {
+			log.Warn(fmt.Sprintf("%s does not have the %s extension", args[0], participation.FileExtension))
+		}
+		file, err := os.ReadFile(args[0])
+		if err != nil {
+			return err
+		}
+
+		client, dir, err := utils.GetClient(dataDir)
+		if err != nil {
+			return err
+		}
+		if utils.IsRemote() {
+			dir = utils.Remote.Endpoint
+		}
+		err = importKey(client, dir, file)
+		if err != nil {
+			return err
+		}
+
+		// Failover nodes are always local
+		for _, failover := range importFailover {
+			client, err := algod.GetClient(failover)
+			if err != nil {
+				return err
+			}
+			err = importKey(client, failover, file)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}, &dataDir)
+
+// importKey installs the key file on the node and logs the imported key.
+func importKey(client api.ClientWithResponsesInterface, node string, file []byte) error {
+	key, err := participation.Import(context.Background(), client, file, importAddress)
+	if err != nil {
+		return fmt.Errorf("failed to import into %s: %w", node, err)
+	}
+	log.Info(style.Green.Render(fmt.Sprintf("Imported key %s for %s into %s", key.Id, key.Address, node)))
+	log.Info(fmt.Sprintf("Valid from round %d to %d", key.Key.VoteFirstValid, key.Key.VoteLastValid))
+	return nil
+}
+
+func init() {
+	importCmd.Flags().StringVarP(&importAddress, "address", "a", "", style.LightBlue("Account the key is expected to belong to"))
+	importCmd.Flags().StringArrayVar(&importFailover, "failover", nil, style.LightBlue("Data directory of a standby node to also install the key into, can be repeated"))
+}
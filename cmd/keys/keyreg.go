@@ -0,0 +1,140 @@
+package keys
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/algorand/go-algorand-sdk/v2/types"
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/participation"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// keyregOnline builds an online key registration
+	keyregOnline bool
+
+	// keyregOffline builds an offline key registration
+	keyregOffline bool
+
+	// keyregOutput is the path of the transaction file, defaults to <id>.<online|offline>.txn
+	keyregOutput string
+
+	// keyregFormat is the encoding of the transaction file
+	keyregFormat = string(participation.MsgpackFormat)
+
+	// keyregValidRounds is the number of rounds the transaction can be submitted in
+	keyregValidRounds = algod.DefaultValidRounds
+)
+
+// keyregCmdShort provides a concise description of the "keyreg" command.
+var keyregCmdShort = "Export an unsigned key registration transaction"
+
+// keyregCmdLong provides a detailed description for the "keyreg" command.
+var keyregCmdLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(keyregCmdShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Builds the online or offline key registration for a participation key on the node",
+	"and writes it as an unsigned transaction file. The msgpack format can be signed",
+	"with `goal clerk sign` or any Algorand SDK, for example on an air-gapped machine.",
+	"",
+	style.Yellow.Render("Note: online keyregs include the 2 ALGO incentive eligibility fee unless disabled."),
+)
+
+// keyregCmd writes the key registration transaction of a participation key to a file.
+var keyregCmd = utils.WithAlgodFlags(&cobra.Command{
+	Use:          "keyreg <id>",
+	Short:        keyregCmdShort,
+	Long:         keyregCmdLong,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format := participation.TxnFormat(keyregFormat)
+		if format != participation.MsgpackFormat && format != participation.JSONFormat {
+			return fmt.Errorf("unsupported format %s", keyregFormat)
+		}
+
+		_, key, txn, err := loadKeyreg(context.Background(), args[0])
+		if err != nil {
+			return err
+		}
+		b, err := participation.EncodeUnsignedTxn(txn, format)
+		if err != nil {
+			return err
+		}
+
+		output := keyregOutput
+		if output == "" {
+			status := "online"
+			if keyregOffline {
+				status = "offline"
+			}
+			output = fmt.Sprintf("%s.%s.txn", key.Id, status)
+			if format == participation.JSONFormat {
+				output += ".json"
+			}
+		}
+		if err := os.WriteFile(output, b, 0644); err != nil {
+			return err
+		}
+		log.Info(style.Green.Render(fmt.Sprintf("Wrote the unsigned keyreg for %s to %s", key.Address, output)))
+		log.Info(fmt.Sprintf("Valid from round %d to %d with a fee of %d microAlgos", txn.FirstValid, txn.LastValid, txn.Fee))
+		return nil
+	},
+}, &dataDir)
+
+// loadKeyreg fetches the participation key from the node and builds the key registration selected by the flags.
+func loadKeyreg(ctx context.Context, id string) (api.ClientWithResponsesInterface, *api.ParticipationKey, types.Transaction, error) {
+	var txn types.Transaction
+	if keyregOnline == keyregOffline {
+		return nil, nil, txn, errors.New("exactly one of --online or --offline is required")
+	}
+	client, _, err := utils.GetClient(dataDir)
+	if err != nil {
+		return nil, nil, txn, err
+	}
+
+	key, response, err := participation.GetKey(ctx, client, id)
+	if err != nil {
+		if response != nil && response.StatusCode() == 404 {
+			return nil, nil, txn, fmt.Errorf("participation key %s not found", id)
+		}
+		return nil, nil, txn, err
+	}
+	params, _, err := algod.GetSuggestedParams(ctx, client, keyregValidRounds)
+	if err != nil {
+		return nil, nil, txn, err
+	}
+	rpcAccount, err := algod.GetAccount(ctx, client, key.Address)
+	if err != nil {
+		return nil, nil, txn, err
+	}
+	acct := algod.Account{Address: key.Address}.Merge(rpcAccount)
+	txn, err = algod.MakeKeyRegTxn(*key, acct, keyregOffline, incentivesDisabled, params)
+	return client, key, txn, err
+}
+
+// keyregFlags adds the flags selecting the key registration to build.
+func keyregFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&keyregOnline, "online", false, style.LightBlue("Register the participation key online"))
+	cmd.Flags().BoolVar(&keyregOffline, "offline", false, style.LightBlue("Register the account of the participation key offline"))
+	cmd.Flags().IntVar(&keyregValidRounds, "valid-rounds", keyregValidRounds, style.LightBlue("Number of rounds the transaction can be submitted in"))
+	cmd.Flags().BoolVarP(&incentivesDisabled, "no-incentives", "n", false, style.LightBlue("Disable setting incentive eligibility fees"))
+}
+
+func init() {
+	keyregFlags(keyregCmd)
+	keyregCmd.Flags().StringVarP(&keyregOutput, "output", "o", "", style.LightBlue("Path of the transaction file, defaults to <id>.<online|offline>.txn"))
+	keyregCmd.Flags().StringVarP(&keyregFormat, "format", "f", keyregFormat, style.LightBlue("Format of the transaction file: msgpack or json"))
+}
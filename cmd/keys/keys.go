@@ -0,0 +1,44 @@
+package keys
+
+import (
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// dataDir path to the algorand data folder
+	dataDir string = ""
+
+	// incentivesDisabled skips the incentive eligibility fee on online key registrations
+	incentivesDisabled bool = false
+
+	// cmdShort provides a concise description of the "keys" command.
+	cmdShort = "Manage the participation keys of your node"
+
+	// cmdLong provides a detailed description of the "keys" command.
+	cmdLong = lipgloss.JoinVertical(
+		lipgloss.Left,
+		style.Purple(style.BANNER),
+		"",
+		style.Bold(cmdShort),
+		"",
+		style.BoldUnderline("Overview:"),
+		"Participation keys are used by online accounts to vote in consensus.",
+		"These commands manage the keys on the node without opening the TUI.",
+	)
+
+	// Cmd represents the root command for managing participation keys.
+	Cmd = &cobra.Command{
+		Use:   "keys",
+		Short: cmdShort,
+		Long:  cmdLong,
+	}
+)
+
+func init() {
+	Cmd.AddCommand(importCmd)
+	Cmd.AddCommand(keyregCmd)
+	Cmd.AddCommand(registerCmd)
+	Cmd.AddCommand(renewCmd)
+}
@@ -0,0 +1,124 @@
+package keys
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/signer"
+	"github.com/algorandfoundation/nodekit/internal/history"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+// registerWallet is the kmd wallet holding the account key, the mnemonic is prompted for when empty
+var registerWallet string
+
+// registerCmdShort provides a concise description of the "register" command.
+var registerCmdShort = "Sign and submit a key registration transaction"
+
+// registerCmdLong provides a detailed description for the "register" command.
+var registerCmdLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(registerCmdShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Builds the online or offline key registration for a participation key on the node,",
+	"signs it with the account mnemonic or a kmd wallet in the data directory,",
+	"submits it to the node and waits for it to be confirmed.",
+	"",
+	style.Yellow.Render("Note: the mnemonic is never echoed or stored. Prefer `keys keyreg` for cold accounts."),
+)
+
+// registerCmd signs the key registration of a participation key locally and submits it.
+var registerCmd = utils.WithAlgodFlags(&cobra.Command{
+	Use:          "register <id>",
+	Short:        registerCmdShort,
+	Long:         registerCmdLong,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		client, key, txn, err := loadKeyreg(ctx, args[0])
+		if err != nil {
+			return err
+		}
+
+		var s signer.Signer
+		if registerWallet != "" {
+			if utils.IsRemote() {
+				return errors.New("--wallet needs the kmd of a local data directory, use the mnemonic for remote nodes")
+			}
+			dir, err := algod.GetDataDir(dataDir)
+			if err != nil {
+				return err
+			}
+			kmdClient, err := signer.GetKmdClient(dir)
+			if err != nil {
+				return err
+			}
+			password, err := utils.PromptSecret("Password for wallet " + registerWallet)
+			if err != nil {
+				return err
+			}
+			kmdSigner, err := signer.NewKmdSigner(kmdClient, registerWallet, password, key.Address)
+			if err != nil {
+				return err
+			}
+			defer kmdSigner.Release()
+			s = kmdSigner
+		} else {
+			phrase, err := utils.PromptSecret("Mnemonic for " + key.Address)
+			if err != nil {
+				return err
+			}
+			s, err = signer.NewMnemonicSigner(phrase)
+			if err != nil {
+				return err
+			}
+		}
+
+		log.Info(fmt.Sprintf("Submitting the keyreg for %s with a fee of %d microAlgos", key.Address, txn.Fee))
+		txid, round, err := algod.SignAndSend(ctx, client, s, txn)
+		if err != nil {
+			return err
+		}
+		log.Info(style.Green.Render(fmt.Sprintf("Transaction %s confirmed in round %d", txid, round)))
+
+		status := "online"
+		if keyregOffline {
+			status = "offline"
+		}
+		err = utils.AppendHistory(history.Record{
+			Time:    time.Now(),
+			Node:    utils.NodeName(dataDir),
+			Type:    history.KeyregRecord,
+			Round:   uint64(round),
+			Address: key.Address,
+			Key:     key.Id,
+			Value:   status,
+			Message: "transaction " + txid,
+		})
+		if err != nil {
+			log.Warn("Failed to record the keyreg in the history: " + err.Error())
+		}
+		return nil
+	},
+}, &dataDir)
+
+func init() {
+	keyregFlags(registerCmd)
+	registerCmd.Flags().StringVar(&registerWallet, "wallet", "", style.LightBlue("Name of the kmd wallet holding the account key, prompts for a mnemonic when empty"))
+}
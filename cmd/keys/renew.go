@@ -0,0 +1,166 @@
+package keys
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/participation"
+	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// renewPolicy is the renewal policy configured by the flags
+	renewPolicy = algod.RenewalPolicy{
+		Before:   algod.DefaultRenewBefore,
+		Duration: algod.DefaultRenewDuration,
+	}
+
+	// renewDilution overrides the key dilution of generated keys when positive
+	renewDilution int
+
+	// renewForce renews the accounts even when the key is not about to expire
+	renewForce bool
+
+	// renewWatch keeps renewing keys in the background
+	renewWatch bool
+
+	// renewNoWait exits once the keys are generated instead of waiting for the registration
+	renewNoWait bool
+)
+
+// renewCmdShort provides a concise description of the "renew" command.
+var renewCmdShort = "Renew participation keys before they expire"
+
+// renewCmdLong provides a detailed description for the "renew" command.
+var renewCmdLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(renewCmdShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Generates an overlapping participation key for every online account whose registered key",
+	"expires within the configured number of rounds and creates the online keyreg link for it.",
+	"Once the new key is registered on-chain and used to vote, about 320 rounds later,",
+	"the previous key is deleted from the node.",
+	"",
+	"Pass addresses to only renew those accounts, or --watch to keep renewing in the background.",
+	"",
+	style.Yellow.Render("Note: the keyreg transaction still has to be signed by the account."),
+)
+
+// renewCmd generates the next participation key for accounts that are about to expire.
+var renewCmd = utils.WithAlgodFlags(&cobra.Command{
+	Use:          "renew [address...]",
+	Short:        renewCmdShort,
+	Long:         renewCmdLong,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		for _, address := range args {
+			if !algod.ValidateAddress(address) {
+				return fmt.Errorf("invalid address %s", address)
+			}
+		}
+		if renewDilution > 0 {
+			renewPolicy.Dilution = &renewDilution
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		httpPkg := new(api.HttpPkg)
+		t := new(system.Clock)
+
+		client, dir, err := utils.GetClient(dataDir)
+		if err != nil {
+			return err
+		}
+		state, response, err := algod.NewStateModel(ctx, client, httpPkg, incentivesDisabled, cmd.Root().Version, dir)
+		utils.WithInvalidResponsesExplanations(err, response, cmd.UsageString())
+		if err != nil {
+			return err
+		}
+		state.UpdateKeys(ctx, t)
+
+		renewer := algod.Renewer{Policy: renewPolicy}
+		renew := func(state *algod.StateModel) error {
+			started, err := renewer.Start(ctx, state, args, renewForce)
+			for _, renewal := range started {
+				printKeyreg(state, renewal)
+			}
+			completed, completeErr := renewer.Complete(ctx, state)
+			for _, renewal := range completed {
+				log.Info(style.Green.Render("Renewed " + renewal.Address + " with key " + renewal.Next.Id))
+			}
+			return errors.Join(err, completeErr)
+		}
+
+		if err := renew(state); err != nil {
+			return err
+		}
+		if !renewWatch && len(renewer.Pending) == 0 {
+			log.Info(style.Green.Render("No accounts need to be renewed"))
+			return nil
+		}
+		if !renewWatch && renewNoWait {
+			return nil
+		}
+
+		events, unsubscribe := state.Subscribe()
+		defer unsubscribe()
+		stopRecording, err := utils.RecordHistory(state, utils.NodeName(dir))
+		if err != nil {
+			return err
+		}
+		defer stopRecording()
+		go func() {
+			_ = state.Watch(ctx, t)
+		}()
+
+		log.Info(style.Green.Render("Waiting for the new keys to be registered and used to vote"))
+		for event := range events {
+			if err := renew(event.State); err != nil {
+				if !renewWatch {
+					return err
+				}
+				log.Error(err)
+			}
+			if !renewWatch && len(renewer.Pending) == 0 {
+				return nil
+			}
+		}
+		return nil
+	},
+}, &dataDir)
+
+// printKeyreg logs the online keyreg link for the next key of a renewal.
+func printKeyreg(state *algod.StateModel, renewal algod.Renewal) {
+	log.Info(style.Green.Render("Generated key " + renewal.Next.Id + " for " + renewal.Address))
+	res, err := participation.GetOnlineShortLink(state.HttpPkg, participation.NewOnlineShortLinkBody(*renewal.Next, state.Status.Network))
+	if err != nil {
+		log.Error(fmt.Sprintf("failed to create the keyreg link: %s", err))
+		return
+	}
+	fee := state.Accounts[renewal.Address].ShouldAddIncentivesFee(state.IncentivesDisabled)
+	log.Info("Sign the online keyreg transaction: " + participation.ToShortLink(res, fee))
+}
+
+func init() {
+	renewCmd.Flags().IntVar(&renewPolicy.Before, "before", renewPolicy.Before, style.LightBlue("Number of rounds before expiry to renew the key"))
+	renewCmd.Flags().IntVar(&renewPolicy.Duration, "duration", renewPolicy.Duration, style.LightBlue("Number of rounds the new key is valid for"))
+	renewCmd.Flags().IntVar(&renewDilution, "dilution", 0, style.LightBlue("Key dilution of the new key, defaults to the node default"))
+	renewCmd.Flags().BoolVarP(&renewForce, "force", "f", false, style.LightBlue("Renew even when the key is not about to expire"))
+	renewCmd.Flags().BoolVarP(&renewWatch, "watch", "w", false, style.LightBlue("Keep renewing keys in the background"))
+	renewCmd.Flags().BoolVar(&renewNoWait, "no-wait", false, style.LightBlue("Exit once the keys are generated, the previous keys are kept"))
+	renewCmd.Flags().BoolVarP(&incentivesDisabled, "no-incentives", "n", false, style.LightBlue("Disable setting incentive eligibility fees"))
+}
@@ -8,14 +8,19 @@ import (
 	"github.com/algorandfoundation/nodekit/api"
 	"github.com/algorandfoundation/nodekit/cmd/catchup"
 	"github.com/algorandfoundation/nodekit/cmd/configure"
+	"github.com/algorandfoundation/nodekit/cmd/keys"
+	"github.com/algorandfoundation/nodekit/cmd/service"
 	"github.com/algorandfoundation/nodekit/cmd/telemetry"
 	"github.com/algorandfoundation/nodekit/cmd/utils"
 	"github.com/algorandfoundation/nodekit/cmd/utils/explanations"
+	"github.com/algorandfoundation/nodekit/cmd/watch"
 	"github.com/algorandfoundation/nodekit/internal/algod"
 	algodutils "github.com/algorandfoundation/nodekit/internal/algod/utils"
+	"github.com/algorandfoundation/nodekit/internal/fleet"
 	"github.com/algorandfoundation/nodekit/internal/system"
 	"github.com/algorandfoundation/nodekit/ui"
 	"github.com/algorandfoundation/nodekit/ui/app"
+	uifleet "github.com/algorandfoundation/nodekit/ui/pages/fleet"
 	"github.com/algorandfoundation/nodekit/ui/style"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -34,6 +39,12 @@ var (
 	// algodEndpoint defines the URI address of the Algorand node, including the protocol (http/https), for client communication.
 	algodData string
 
+	// algodDataDirs are the data directories of the nodes watched by the TUI
+	algodDataDirs []string
+
+	// fleetConfig is the path of a config listing the nodes watched by the TUI
+	fleetConfig string
+
 	// force indicates whether actions should be performed forcefully, bypassing checks or confirmations.
 	force bool = false
 
@@ -48,10 +59,13 @@ var (
 		"Welcome to NodeKit, a TUI for managing Algorand nodes.",
 		"A one stop shop for managing Algorand nodes, including node creation, configuration, and management.",
 		"",
+		"Repeat --datadir or use --fleet to watch several nodes from a single session.",
+		"Use --endpoint with --token, --token-file or "+algod.TokenEnv+" to manage a remote node.",
+		"",
 		style.Yellow.Render(explanations.ExperimentalWarning),
 	)
 	// RootCmd is the primary command for managing Algorand nodes, providing CLI functionality and TUI for interaction.
-	RootCmd = utils.WithAlgodFlags(&cobra.Command{
+	RootCmd = utils.WithHistoryFlags(utils.WithRemoteFlags(utils.WithFleetFlags(&cobra.Command{
 		Use:   Name,
 		Short: short,
 		Long:  long,
@@ -60,12 +74,26 @@ var (
 		},
 		Run: func(cmd *cobra.Command, args []string) {
 			log.SetOutput(cmd.OutOrStdout())
-			err := runTUI(cmd, algodData, IncentivesDisabled, cmd.Version)
+			if utils.IsRemote() {
+				if err := runTUI(cmd, "", IncentivesDisabled, cmd.Version); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+			nodes, err := getFleetNodes()
+			if err != nil {
+				log.Fatal(err)
+			}
+			if len(nodes) > 1 {
+				err = runFleetTUI(cmd, nodes, IncentivesDisabled, cmd.Version)
+			} else {
+				err = runTUI(cmd, nodes[0].DataDir, IncentivesDisabled, cmd.Version)
+			}
 			if err != nil {
 				log.Fatal(err)
 			}
 		},
-	}, &algodData)
+	}, &algodDataDirs, &fleetConfig)))
 )
 
 // NeedsToBeRunning ensures the Algod software is installed and running before executing the associated Cobra command.
@@ -99,18 +127,28 @@ func init() {
 	log.SetReportTimestamp(false)
 	RootCmd.Flags().BoolVarP(&IncentivesDisabled, "no-incentives", "n", false, style.LightBlue("Disable setting incentive eligibility fees"))
 	RootCmd.SetVersionTemplate(fmt.Sprintf("nodekit-%s-%s@{{.Version}}\n", runtime.GOARCH, runtime.GOOS))
-	// Add Commands
+	// Run the --endpoint check of the root command before the hooks of the subcommands
+	cobra.EnableTraverseRunHooks = true
+	utils.WithRemote(RootCmd)
+	// Add Commands, only the ones talking to the REST API support remote nodes
 	if runtime.GOOS != "windows" {
+		RootCmd.AddCommand(utils.WithRemote(alertsCmd))
 		RootCmd.AddCommand(bootstrapCmd)
 		RootCmd.AddCommand(debugCmd)
+		RootCmd.AddCommand(utils.WithRemote(exporterCmd))
+		RootCmd.AddCommand(historyCmd)
 		RootCmd.AddCommand(installCmd)
 		RootCmd.AddCommand(startCmd)
+		RootCmd.AddCommand(utils.WithRemote(statusCmd))
 		RootCmd.AddCommand(stopCmd)
 		RootCmd.AddCommand(uninstallCmd)
 		RootCmd.AddCommand(upgradeCmd)
-		RootCmd.AddCommand(catchup.Cmd)
+		RootCmd.AddCommand(utils.WithRemote(catchup.Cmd))
 		RootCmd.AddCommand(configure.Cmd)
+		RootCmd.AddCommand(utils.WithRemote(keys.Cmd))
+		RootCmd.AddCommand(service.Cmd)
 		RootCmd.AddCommand(telemetry.Cmd)
+		RootCmd.AddCommand(watch.Cmd)
 	}
 }
 
@@ -126,21 +164,19 @@ func runTUI(cmd *cobra.Command, algodData string, incentivesFlag bool, version s
 		return fmt.Errorf("cmd is nil")
 	}
 	// Create the dependencies
-	ctx := context.Background()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 	httpPkg := new(api.HttpPkg)
 	t := new(system.Clock)
 
-	dataDir, err := algod.GetDataDir(algodData)
-	if err != nil {
-		log.Fatal(err)
-	}
-	client, err := algod.GetClient(dataDir)
+	client, dataDir, err := utils.GetClient(algodData)
 	cobra.CheckErr(err)
 
 	// Fetch the state and handle any creation errors
 	state, stateResponse, err := algod.NewStateModel(ctx, client, httpPkg, incentivesFlag, version, dataDir)
 	utils.WithInvalidResponsesExplanations(err, stateResponse, cmd.UsageString())
 	cobra.CheckErr(err)
+	state.WatchAccounts = utils.WatchAddresses()
 	// Construct the TUI Model from the State
 	m, err := ui.NewViewportViewModel(state)
 	cobra.CheckErr(err)
@@ -152,8 +188,20 @@ func runTUI(cmd *cobra.Command, algodData string, incentivesFlag bool, version s
 		tea.WithFPS(120),
 	)
 
-	// Watch for State Updates on a separate thread
-	// TODO: refactor into context aware watcher without callbacks
+	// Start watching the node, the watcher stops when the TUI exits
+	events, unsubscribe := state.Subscribe()
+	defer unsubscribe()
+	stopRecording, err := utils.RecordHistory(state, utils.NodeName(dataDir))
+	if err != nil {
+		return err
+	}
+	defer stopRecording()
+	defer utils.TrackRoundTime(state)()
+	go func() {
+		_ = state.Watch(ctx, t)
+	}()
+
+	// Forward watcher events to the TUI on a separate thread
 	go func() {
 		// Check if the instance is lagging
 		lagging, err := algod.IsLagging(httpPkg, state.Status.LastRound, state.Status.Network)
@@ -165,32 +213,132 @@ func runTUI(cmd *cobra.Command, algodData string, incentivesFlag bool, version s
 
 		// Display Hybrid Notice on launch
 		// Only shown if EnableP2PHybridMode is unset/false and hasn't already been set to "do not show again"
+		// Remote nodes have no local config to change
 		hybridEnabled := m.Data.Config.EnableP2PHybridMode != nil && *m.Data.Config.EnableP2PHybridMode
-		if !hybridEnabled && algodutils.ShowHybridPopUp() {
+		if !hybridEnabled && !utils.IsRemote() && algodutils.ShowHybridPopUp() {
 			p.Send(app.HybridModal)
 		}
 
-		state.Watch(func(status *algod.StateModel, err error) {
+		for event := range events {
 			// Handle Fast Catchup
-			if state.Status.State == algod.FastCatchupState {
+			if event.State.Status.State == algod.FastCatchupState {
 				p.Send(app.CatchupModal)
 				lagging = false
-			} else if lagging && status.Status.State != algod.FastCatchupState {
+			} else if lagging {
 				p.Send(app.LaggingModal)
 				lagging = false
 			}
 
-			if err == nil {
-				p.Send(state)
+			p.Send(event.State)
+			if event.Err != nil {
+				p.Send(event.Err)
 			}
-			if err != nil {
-				p.Send(state)
-				p.Send(err)
-			}
-		}, ctx, t)
+		}
 	}()
 
 	// Execute the TUI Application
 	_, err = p.Run()
 	return err
 }
+
+// getFleetNodes returns the nodes selected by the --datadir and --fleet flags.
+// A single node with the default data directory is returned when neither is set.
+func getFleetNodes() ([]fleet.Node, error) {
+	nodes := fleet.FromDataDirs(algodDataDirs)
+	if fleetConfig != "" {
+		config, err := fleet.Load(fleetConfig)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, config.Nodes...)
+	}
+	if len(nodes) == 0 {
+		return []fleet.Node{{}}, nil
+	}
+	return fleet.Merge(nodes)
+}
+
+// watchedState builds the StateModel of a node of the fleet.
+func watchedState(ctx context.Context, node fleet.Node, httpPkg api.HttpPkgInterface, incentivesFlag bool, version string) (*algod.StateModel, error) {
+	dataDir, err := algod.GetDataDir(node.DataDir)
+	if err != nil {
+		return nil, err
+	}
+	client, err := algod.GetClient(dataDir)
+	if err != nil {
+		return nil, err
+	}
+	state, _, err := algod.NewStateModel(ctx, client, httpPkg, incentivesFlag, version, dataDir)
+	return state, err
+}
+
+// runFleetTUI watches every node and starts the TUI on the fleet overview.
+func runFleetTUI(cmd *cobra.Command, nodes []fleet.Node, incentivesFlag bool, version string) error {
+	if cmd == nil {
+		return fmt.Errorf("cmd is nil")
+	}
+	// Create the dependencies
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	httpPkg := new(api.HttpPkg)
+	t := new(system.Clock)
+
+	// Fetch the state of every node
+	var fleetNodes []uifleet.Node
+	watchAccounts := utils.WatchAddresses()
+	for _, node := range nodes {
+		state, err := watchedState(ctx, node, httpPkg, incentivesFlag, version)
+		if err != nil {
+			// Show the node as DOWN with its error and keep watching the others
+			fleetNodes = append(fleetNodes, uifleet.Node{
+				Name:  node.Name,
+				State: &algod.StateModel{Status: algod.Status{State: algod.DownState}, Version: version},
+				Err:   err,
+			})
+			continue
+		}
+		state.WatchAccounts = watchAccounts
+		fleetNodes = append(fleetNodes, uifleet.Node{Name: node.Name, State: state})
+	}
+
+	// Construct the TUI Model from the States
+	m, err := ui.NewFleetViewportViewModel(fleetNodes)
+	if err != nil {
+		return err
+	}
+	p := tea.NewProgram(
+		m,
+		tea.WithAltScreen(),
+		tea.WithFPS(120),
+	)
+
+	// Watch every node and forward the events, the viewport filters them by the selected node
+	for _, node := range fleetNodes {
+		if node.Err != nil {
+			continue
+		}
+		events, unsubscribe := node.State.Subscribe()
+		defer unsubscribe()
+		stopRecording, err := utils.RecordHistory(node.State, node.Name)
+		if err != nil {
+			return err
+		}
+		defer stopRecording()
+		defer utils.TrackRoundTime(node.State)()
+		go func() {
+			_ = node.State.Watch(ctx, t)
+		}()
+		go func() {
+			for event := range events {
+				p.Send(event.State)
+				if event.Err != nil {
+					p.Send(app.NodeError{State: event.State, Err: event.Err})
+				}
+			}
+		}()
+	}
+
+	// Execute the TUI Application
+	_, err = p.Run()
+	return err
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+var short = "Inspect the algod service"
+
+var long = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(short),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Shows how the Algorand daemon is managed on this host and the resources used by its process.",
+)
+
+var algodData = ""
+
+var Cmd = &cobra.Command{
+	Use:   "service",
+	Short: short,
+	Long:  long,
+}
+
+func init() {
+	Cmd.AddCommand(statusCmd)
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/algorandfoundation/nodekit/ui/utils"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// statusOutput is the format used to render the service status, one of "table", "json" or "yaml".
+var statusOutput = "table"
+
+var statusShort = "Display the status of the algod service"
+
+var statusLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(statusShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Reports whether algod is managed by systemd, as a system or user service, launchd or was started without a service manager,",
+	"the state of the service, its uptime, restarts and last exit, and the memory, CPU,",
+	"open files and listening ports of its process.",
+	"A service restarted less than a minute ago, or waiting to be restarted, is reported as crash-looping.",
+	"The resources of the process are only available on Linux, the open files and ports need root",
+	"when algod runs as another user.",
+	"",
+	style.BoldUnderline("Example:"),
+	"  nodekit service status",
+	"  nodekit service status -o json",
+)
+
+// statusCmd prints the ServiceStatus of the local node.
+var statusCmd = cmdutils.WithAlgodFlags(&cobra.Command{
+	Use:          "status",
+	Short:        statusShort,
+	Long:         statusLong,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		clock := new(system.Clock)
+		// The data directory is only needed without a service manager
+		dataDir, _ := algod.GetDataDir(algodData)
+		status := algod.GetServiceStatus(clock, dataDir)
+
+		var out string
+		switch statusOutput {
+		case "json":
+			data, err := json.MarshalIndent(status, "", "  ")
+			if err != nil {
+				return err
+			}
+			out = string(data)
+		case "yaml":
+			data, err := yaml.Marshal(status)
+			if err != nil {
+				return err
+			}
+			out = string(data)
+		case "table":
+			out = statusTable(status, clock.Now())
+		default:
+			return fmt.Errorf("unsupported output format %q, use one of table, json or yaml", statusOutput)
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), out)
+
+		if statusOutput == "table" && status.IsCrashLooping(clock.Now()) {
+			restarts := int(status.Restarts)
+			log.Warnf("algod is crash-looping, it was restarted %d %s", restarts, utils.Plural("time", restarts))
+		}
+		return nil
+	},
+}, &algodData)
+
+// statusTable renders the ServiceStatus as a two columns table.
+func statusTable(s algod.ServiceStatus, now time.Time) string {
+	state := "stopped"
+	if s.Running {
+		state = "running"
+	}
+	if s.ActiveState != "" {
+		state = fmt.Sprintf("%s (%s)", s.ActiveState, s.SubState)
+	}
+	if s.IsCrashLooping(now) {
+		state = style.Yellow.Render(state + " CRASH-LOOP")
+	} else if s.Running {
+		state = style.Green.Render(state)
+	} else {
+		state = style.Red.Render(state)
+	}
+
+	unknown := "n/a"
+	pid, uptime, rss, cpu, openFiles, listening := unknown, unknown, unknown, unknown, unknown, unknown
+	if s.PID != 0 {
+		pid = strconv.Itoa(s.PID)
+	}
+	if s.Running && s.StartedAt != nil {
+		uptime = fmt.Sprintf("%s (since %s)", utils.Uptime(s.Uptime(now)), s.StartedAt.Local().Format(time.DateTime))
+	}
+	if s.RSS != 0 {
+		rss = fmt.Sprintf("%.1f MiB", float64(s.RSS)/(1<<20))
+		cpu = fmt.Sprintf("%.1f%%", s.CPU)
+	}
+	if s.OpenFiles >= 0 {
+		openFiles = strconv.Itoa(s.OpenFiles)
+	}
+	if s.Listening != nil {
+		listening = strings.Join(s.Listening, ", ")
+	}
+	lastExit := unknown
+	if s.Result != "" {
+		lastExit = fmt.Sprintf("%s, exit status %d", s.Result, s.ExitStatus)
+	}
+	unit := s.Unit
+	if unit == "" {
+		unit = unknown
+	}
+	manager := s.Manager
+	if s.Manager == algod.SystemdUserManager && s.Linger {
+		manager += " (linger)"
+	} else if s.Manager == algod.SystemdUserManager {
+		manager += " (stops on logout)"
+	}
+
+	return table.New().
+		Border(lipgloss.NormalBorder()).
+		Rows(
+			[]string{"Manager", manager},
+			[]string{"Unit", unit},
+			[]string{"State", state},
+			[]string{"PID", pid},
+			[]string{"Uptime", uptime},
+			[]string{"Restarts", strconv.Itoa(int(s.Restarts))},
+			[]string{"Last exit", lastExit},
+			[]string{"Memory", rss},
+			[]string{"CPU", cpu},
+			[]string{"Open files", openFiles},
+			[]string{"Listening", listening},
+		).
+		String()
+}
+
+func init() {
+	statusCmd.Flags().StringVarP(&statusOutput, "output", "o", "table", style.LightBlue("Output format: table, json or yaml"))
+}
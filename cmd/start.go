@@ -33,8 +33,10 @@ var startCmd = cmdutils.WithAlgodFlags(&cobra.Command{
 	PersistentPreRun: NeedsToBeStopped,
 	Run: func(cmd *cobra.Command, args []string) {
 		log.Info(style.Green.Render("Starting Algod 🚀"))
-		// Warn user for prompt
-		log.Warn(style.Yellow.Render(explanations.SudoWarningMsg))
+		// Warn user for prompt, a user service needs no password
+		if !algod.IsUserService() {
+			log.Warn(style.Yellow.Render(explanations.SudoWarningMsg))
+		}
 		err := algod.Start()
 		if err != nil {
 			log.Fatal(err)
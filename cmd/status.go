@@ -0,0 +1,428 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/api"
+	cmdutils "github.com/algorandfoundation/nodekit/cmd/utils"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// StatusExitOK is returned when the node is running and no participation key has expired.
+	StatusExitOK = 0
+
+	// StatusExitError is returned when the node responded with an error, like an invalid token.
+	StatusExitError = 1
+
+	// StatusExitDown is returned when the node could not be reached.
+	StatusExitDown = 2
+
+	// StatusExitSyncing is returned while the node is syncing or running a Fast-Catchup.
+	StatusExitSyncing = 3
+
+	// StatusExitExpiredKeys is returned when an online account is using an expired participation key.
+	StatusExitExpiredKeys = 4
+)
+
+// statusOutput is the format used to render the status report, one of "table", "json" or "yaml".
+var statusOutput = "table"
+
+// statusScan is the number of recent blocks scanned for proposals by the accounts.
+var statusScan = 0
+
+// StatusNode describes the algod node in a StatusReport.
+type StatusNode struct {
+	State       algod.State `json:"state" yaml:"state"`
+	Network     string      `json:"network" yaml:"network"`
+	Version     string      `json:"version" yaml:"version"`
+	LastRound   uint64      `json:"lastRound" yaml:"lastRound"`
+	NeedsUpdate bool        `json:"needsUpdate" yaml:"needsUpdate"`
+	Admin       bool        `json:"admin" yaml:"admin"`
+}
+
+// StatusMetrics describes the round averages and network rates in a StatusReport.
+type StatusMetrics struct {
+	Enabled          bool    `json:"enabled" yaml:"enabled"`
+	Window           int     `json:"window" yaml:"window"`
+	RoundTimeSeconds float64 `json:"roundTimeSeconds" yaml:"roundTimeSeconds"`
+	TPS              float64 `json:"tps" yaml:"tps"`
+	RX               int     `json:"rxBytesPerSecond" yaml:"rxBytesPerSecond"`
+	TX               int     `json:"txBytesPerSecond" yaml:"txBytesPerSecond"`
+}
+
+// StatusAccount describes an account with participation keys on the node in a StatusReport.
+type StatusAccount struct {
+	Address           string     `json:"address" yaml:"address"`
+	Status            string     `json:"status" yaml:"status"`
+	Balance           int        `json:"balance" yaml:"balance"`
+	IncentiveEligible bool       `json:"incentiveEligible" yaml:"incentiveEligible"`
+	NonResidentKey    bool       `json:"nonResidentKey" yaml:"nonResidentKey"`
+	WatchOnly         bool       `json:"watchOnly" yaml:"watchOnly"`
+	Keys              int        `json:"keys" yaml:"keys"`
+	Expires           *time.Time `json:"expires" yaml:"expires"`
+	ExpiresEarliest   *time.Time `json:"expiresEarliest" yaml:"expiresEarliest"`
+	ExpiresLatest     *time.Time `json:"expiresLatest" yaml:"expiresLatest"`
+	Expired           bool       `json:"expired" yaml:"expired"`
+	Proposals         int        `json:"proposals" yaml:"proposals"`
+	ExpectedProposals float64    `json:"expectedProposals" yaml:"expectedProposals"`
+	ProposerRewards   int        `json:"proposerRewards" yaml:"proposerRewards"`
+	LastProposal      uint64     `json:"lastProposal" yaml:"lastProposal"`
+	SinceLastProposal float64    `json:"secondsSinceLastProposal" yaml:"secondsSinceLastProposal"`
+}
+
+// StatusPerformance describes the blocks scanned for proposals in a StatusReport.
+type StatusPerformance struct {
+	StartRound  uint64 `json:"startRound" yaml:"startRound"`
+	LastRound   uint64 `json:"lastRound" yaml:"lastRound"`
+	Rounds      int    `json:"rounds" yaml:"rounds"`
+	OnlineMoney int    `json:"onlineMoney" yaml:"onlineMoney"`
+}
+
+// StatusKey describes a participation key installed on the node in a StatusReport.
+type StatusKey struct {
+	Id                string `json:"id" yaml:"id"`
+	Address           string `json:"address" yaml:"address"`
+	VoteFirstValid    int    `json:"voteFirstValid" yaml:"voteFirstValid"`
+	VoteLastValid     int    `json:"voteLastValid" yaml:"voteLastValid"`
+	VoteKeyDilution   int    `json:"voteKeyDilution" yaml:"voteKeyDilution"`
+	LastVote          *int   `json:"lastVote" yaml:"lastVote"`
+	LastBlockProposal *int   `json:"lastBlockProposal" yaml:"lastBlockProposal"`
+	Expired           bool   `json:"expired" yaml:"expired"`
+}
+
+// StatusReport is the machine-readable representation of the StateModel printed by the status command.
+type StatusReport struct {
+	NodeKit     string            `json:"nodekit" yaml:"nodekit"`
+	Healthy     bool              `json:"healthy" yaml:"healthy"`
+	Problems    []string          `json:"problems" yaml:"problems"`
+	Node        StatusNode        `json:"node" yaml:"node"`
+	Metrics     StatusMetrics     `json:"metrics" yaml:"metrics"`
+	Performance StatusPerformance `json:"performance" yaml:"performance"`
+	Accounts    []StatusAccount   `json:"accounts" yaml:"accounts"`
+	Keys        []StatusKey       `json:"keys" yaml:"keys"`
+
+	// exitCode is the process exit code matching the report
+	exitCode int
+}
+
+// NewStatusReport builds a StatusReport from the StateModel and derives its health and exit code.
+func NewStatusReport(state *algod.StateModel, version string) StatusReport {
+	report := StatusReport{
+		NodeKit:  version,
+		Problems: []string{},
+		Node: StatusNode{
+			State:       state.Status.State,
+			Network:     state.Status.Network,
+			Version:     state.Status.Version,
+			LastRound:   state.Status.LastRound,
+			NeedsUpdate: state.Status.NeedsUpdate,
+			Admin:       state.Admin,
+		},
+		Metrics: StatusMetrics{
+			Enabled:          state.Metrics.Enabled,
+			Window:           state.Metrics.Window,
+			RoundTimeSeconds: state.Metrics.RoundTime.Seconds(),
+			TPS:              state.Metrics.TPS,
+			RX:               state.Metrics.RX,
+			TX:               state.Metrics.TX,
+		},
+		Performance: StatusPerformance{
+			StartRound:  state.Performance.StartRound,
+			LastRound:   state.Performance.LastRound,
+			Rounds:      state.Performance.Rounds,
+			OnlineMoney: state.Performance.OnlineMoney,
+		},
+		Accounts: []StatusAccount{},
+		Keys:     []StatusKey{},
+		exitCode: StatusExitOK,
+	}
+
+	lastRound := int(state.Status.LastRound)
+	for _, key := range state.ParticipationKeys {
+		report.Keys = append(report.Keys, StatusKey{
+			Id:                key.Id,
+			Address:           key.Address,
+			VoteFirstValid:    key.Key.VoteFirstValid,
+			VoteLastValid:     key.Key.VoteLastValid,
+			VoteKeyDilution:   key.Key.VoteKeyDilution,
+			LastVote:          key.LastVote,
+			LastBlockProposal: key.LastBlockProposal,
+			Expired:           lastRound > 0 && key.Key.VoteLastValid < lastRound,
+		})
+	}
+	sort.SliceStable(report.Keys, func(i, j int) bool {
+		return report.Keys[i].Id < report.Keys[j].Id
+	})
+
+	expiredKeys := false
+	for _, acct := range state.Accounts {
+		expired := acct.Participation != nil && lastRound > 0 && acct.Participation.VoteLastValid < lastRound
+		if expired && acct.Status == "Online" {
+			expiredKeys = true
+			report.Problems = append(report.Problems, fmt.Sprintf("%s is online with an expired participation key", acct.Address))
+		}
+		stats := state.Performance.Accounts[acct.Address]
+		lastProposal := stats.LastProposal
+		if uint64(acct.LastProposed) > lastProposal {
+			lastProposal = uint64(acct.LastProposed)
+		}
+		stats.LastProposal = lastProposal
+		report.Accounts = append(report.Accounts, StatusAccount{
+			Address:           acct.Address,
+			Status:            acct.Status,
+			Balance:           acct.Balance,
+			IncentiveEligible: acct.IncentiveEligible,
+			NonResidentKey:    acct.NonResidentKey,
+			WatchOnly:         acct.WatchOnly,
+			Keys:              acct.Keys,
+			Expires:           acct.Expires,
+			ExpiresEarliest:   acct.ExpiresEarliest,
+			ExpiresLatest:     acct.ExpiresLatest,
+			Expired:           expired,
+			Proposals:         stats.Proposals,
+			ExpectedProposals: stats.Expected,
+			ProposerRewards:   stats.Rewards,
+			LastProposal:      lastProposal,
+			SinceLastProposal: stats.SinceLastProposal(state.Status.LastRound, state.RoundTime().RoundTime).Seconds(),
+		})
+	}
+	sort.SliceStable(report.Accounts, func(i, j int) bool {
+		return report.Accounts[i].Address < report.Accounts[j].Address
+	})
+	sort.Strings(report.Problems)
+
+	// The node state takes precedence over the keys
+	switch state.Status.State {
+	case algod.DownState:
+		report.exitCode = StatusExitDown
+		report.Problems = append([]string{"node is not responding"}, report.Problems...)
+	case algod.SyncingState, algod.FastCatchupState:
+		report.exitCode = StatusExitSyncing
+		report.Problems = append([]string{fmt.Sprintf("node is %s", state.Status.State)}, report.Problems...)
+	default:
+		if expiredKeys {
+			report.exitCode = StatusExitExpiredKeys
+		}
+	}
+	report.Healthy = report.exitCode == StatusExitOK
+
+	return report
+}
+
+// ExitCode returns the process exit code for the report.
+func (r StatusReport) ExitCode() int {
+	return r.exitCode
+}
+
+// Table renders the report for humans.
+func (r StatusReport) Table() string {
+	var (
+		cellStyle      = lipgloss.NewStyle().Padding(0, 1, 0, 0)
+		optionRowStyle = cellStyle.Align(lipgloss.Right)
+		valueRowStyle  = cellStyle.Align(lipgloss.Left)
+	)
+
+	state := style.Green.Render(string(r.Node.State))
+	if !r.Healthy {
+		state = style.Yellow.Render(string(r.Node.State))
+	}
+	nodeTable := table.New().
+		Border(lipgloss.HiddenBorder()).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if col == 0 {
+				return optionRowStyle
+			}
+			return valueRowStyle
+		}).
+		Rows(
+			[]string{"State:", state},
+			[]string{"Network:", r.Node.Network},
+			[]string{"Version:", r.Node.Version},
+			[]string{"Last Round:", strconv.FormatUint(r.Node.LastRound, 10)},
+			[]string{"Round Time:", fmt.Sprintf("%.2fs", r.Metrics.RoundTimeSeconds)},
+			[]string{"TPS:", fmt.Sprintf("%.2f", r.Metrics.TPS)},
+		)
+
+	rows := make([][]string, 0, len(r.Accounts))
+	for _, acct := range r.Accounts {
+		expires := "N/A"
+		if acct.Expired {
+			expires = "EXPIRED"
+		} else if acct.Expires != nil {
+			expires = acct.Expires.Format(time.RFC822)
+		}
+		if acct.NonResidentKey {
+			expires = "NON-RESIDENT-KEY"
+		}
+		if acct.WatchOnly {
+			expires = "REMOTE"
+		}
+		row := []string{
+			acct.Address,
+			acct.Status,
+			strconv.FormatBool(acct.IncentiveEligible),
+			expires,
+			strconv.Itoa(acct.Balance),
+			strconv.Itoa(acct.Keys),
+		}
+		// The proposals are only known when blocks were scanned
+		if r.Performance.Rounds > 0 {
+			row = append(row,
+				fmt.Sprintf("%d/%.2f", acct.Proposals, acct.ExpectedProposals),
+				fmt.Sprintf("%.6f", float64(acct.ProposerRewards)/1000000),
+			)
+		}
+		lastProposal := "N/A"
+		if acct.LastProposal > 0 {
+			lastProposal = strconv.FormatUint(acct.LastProposal, 10)
+		}
+		rows = append(rows, append(row, lastProposal))
+	}
+	headers := []string{"Account", "Status", "Eligible", "Expires", "Balance", "Keys"}
+	if r.Performance.Rounds > 0 {
+		headers = append(headers, "Proposed/Expected", "Rewards")
+	}
+	headers = append(headers, "Last Proposal")
+	accountsTable := table.New().
+		Border(lipgloss.NormalBorder()).
+		Headers(headers...).
+		Rows(rows...)
+
+	views := []string{
+		style.BoldUnderline("Node:"),
+		nodeTable.String(),
+		style.BoldUnderline("Accounts:"),
+		accountsTable.String(),
+	}
+	if len(r.Problems) > 0 {
+		views = append(views, style.BoldUnderline("Problems:"))
+		for _, problem := range r.Problems {
+			views = append(views, style.Red.Render("✗ "+problem))
+		}
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, views...)
+}
+
+// Render formats the report using the requested output format.
+func (r StatusReport) Render(output string) (string, error) {
+	switch output {
+	case "json":
+		data, err := json.MarshalIndent(r, "", "  ")
+		return string(data), err
+	case "yaml":
+		data, err := yaml.Marshal(r)
+		return string(data), err
+	case "table":
+		return r.Table(), nil
+	default:
+		return "", fmt.Errorf("unsupported output format %q, use one of table, json or yaml", output)
+	}
+}
+
+var statusShort = "Display the status of the node"
+
+var statusLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(statusShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Prints the node status, metrics, accounts and participation keys without opening the TUI.",
+	"Use --output json or --output yaml for scripts.",
+	"Use --scan to count the block proposals of the accounts in the recent rounds.",
+	"",
+	style.BoldUnderline("Exit codes:"),
+	fmt.Sprintf("%d: the node is running and healthy", StatusExitOK),
+	fmt.Sprintf("%d: the node responded with an error, like an invalid token", StatusExitError),
+	fmt.Sprintf("%d: the node is not responding", StatusExitDown),
+	fmt.Sprintf("%d: the node is syncing or running a Fast-Catchup", StatusExitSyncing),
+	fmt.Sprintf("%d: an online account has an expired participation key", StatusExitExpiredKeys),
+)
+
+// statusCmd prints the StateModel in a human or machine-readable format.
+var statusCmd = cmdutils.WithAlgodFlags(&cobra.Command{
+	Use:          "status",
+	Short:        statusShort,
+	Long:         statusLong,
+	SilenceUsage: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		httpPkg := new(api.HttpPkg)
+
+		// Validate the format before talking to the node
+		if _, err := (StatusReport{}).Render(statusOutput); err != nil {
+			log.Fatal(err)
+		}
+
+		client, dataDir, err := cmdutils.GetClient(algodData)
+		cobra.CheckErr(err)
+
+		state, response, err := algod.NewStateModel(ctx, client, httpPkg, IncentivesDisabled, cmd.Root().Version, dataDir)
+		if err != nil && !isNodeDown(err) {
+			// The node responded, an invalid token or a failed request is not a down node
+			if response != nil {
+				cmdutils.WithInvalidResponsesExplanations(err, response, cmd.UsageString())
+			}
+			log.Error(err)
+			os.Exit(StatusExitError)
+		}
+		if err != nil {
+			// Report the node as down instead of failing, scripts rely on the exit code
+			state = &algod.StateModel{Status: algod.Status{State: algod.DownState}}
+		} else {
+			// Fetch the accounts for each participation key and the watched accounts
+			state.WatchAccounts = cmdutils.WatchAddresses()
+			cmdutils.LoadRoundTime(state)
+			state.UpdateKeys(ctx, new(system.Clock))
+			if statusScan > 0 && state.Status.LastRound > 0 {
+				last := state.Status.LastRound
+				first := uint64(1)
+				if last > uint64(statusScan) {
+					first = last - uint64(statusScan) + 1
+				}
+				err = state.Performance.Scan(ctx, client, first, last, state.Accounts)
+				if err != nil {
+					log.Warnf("Unable to scan blocks for proposals: %s", err)
+				}
+			}
+		}
+
+		report := NewStatusReport(state, cmd.Root().Version)
+		out, err := report.Render(statusOutput)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), out)
+		os.Exit(report.ExitCode())
+	},
+}, &algodData)
+
+// isNodeDown reports whether the error means the node could not be reached,
+// the other errors come from a node that responded.
+func isNodeDown(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, context.DeadlineExceeded)
+}
+
+func init() {
+	statusCmd.Flags().StringVarP(&statusOutput, "output", "o", "table", style.LightBlue("Output format: table, json or yaml"))
+	statusCmd.Flags().IntVar(&statusScan, "scan", 0, style.LightBlue("Number of recent blocks to scan for proposals by the accounts"))
+}
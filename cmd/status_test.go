@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"syscall"
+	"testing"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/test"
+	"github.com/algorandfoundation/nodekit/internal/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+// statusState returns a StateModel with the participation keys of the mock client,
+// the ExpiredAccount is online with a key valid until round 30000.
+func statusState(state algod.State, lastRound int) *algod.StateModel {
+	client := test.GetClient(false)
+	accounts := algod.ParticipationKeysToAccounts(mock.Keys)
+	accounts[mock.ExpiredAccount.Address] = algod.Account{
+		Address:       mock.ExpiredAccount.Address,
+		Status:        mock.ExpiredAccount.Status,
+		Participation: mock.ExpiredAccount.Participation,
+		Keys:          1,
+	}
+	return &algod.StateModel{
+		Status: algod.Status{
+			State:     state,
+			LastRound: uint64(lastRound),
+		},
+		Accounts:          accounts,
+		ParticipationKeys: mock.Keys,
+		Client:            client,
+		HttpPkg:           new(api.HttpPkg),
+	}
+}
+
+func Test_NewStatusReport(t *testing.T) {
+	tests := []struct {
+		name     string
+		state    *algod.StateModel
+		exitCode int
+		healthy  bool
+		problems []string
+	}{
+		{"Stable", statusState(algod.StableState, 1000), StatusExitOK, true, []string{}},
+		{"Down", statusState(algod.DownState, 1000), StatusExitDown, false, []string{"node is not responding"}},
+		{"Syncing", statusState(algod.SyncingState, 1000), StatusExitSyncing, false, []string{"node is SYNCING"}},
+		{"FastCatchup", statusState(algod.FastCatchupState, 1000), StatusExitSyncing, false, []string{"node is FAST-CATCHUP"}},
+		{"ExpiredKeys", statusState(algod.StableState, 40000), StatusExitExpiredKeys, false, []string{"EXPIRED is online with an expired participation key"}},
+		// The node state takes precedence over the keys
+		{"DownExpiredKeys", statusState(algod.DownState, 40000), StatusExitDown, false, []string{"node is not responding", "EXPIRED is online with an expired participation key"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := NewStatusReport(tt.state, "vTest")
+			assert.Equal(t, tt.exitCode, report.ExitCode())
+			assert.Equal(t, tt.healthy, report.Healthy)
+			assert.Equal(t, tt.problems, report.Problems)
+			assert.Equal(t, "vTest", report.NodeKit)
+			assert.Len(t, report.Keys, len(mock.Keys))
+		})
+	}
+}
+
+func Test_IsNodeDown(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		down bool
+	}{
+		{"Refused", &url.Error{Op: "Get", URL: "http://localhost:8080/v2/status", Err: syscall.ECONNREFUSED}, true},
+		{"Timeout", fmt.Errorf("failed to get status: %w", context.DeadlineExceeded), true},
+		{"Unauthorized", errors.New(algod.InvalidStatus), false},
+		{"Other", errors.New("unexpected response"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.down, isNodeDown(tt.err))
+		})
+	}
+}
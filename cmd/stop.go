@@ -45,8 +45,10 @@ var stopCmd = cmdutils.WithAlgodFlags(&cobra.Command{
 	PersistentPreRun: NeedsToBeRunning,
 	Run: func(cmd *cobra.Command, args []string) {
 		log.Info(style.Green.Render(StoppingAlgodMsg))
-		// Warn user for prompt
-		log.Warn(style.Yellow.Render(explanations.SudoWarningMsg))
+		// Warn user for prompt, a user service needs no password
+		if !algod.IsUserService() {
+			log.Warn(style.Yellow.Render(explanations.SudoWarningMsg))
+		}
 
 		err := algod.Stop()
 		if err != nil {
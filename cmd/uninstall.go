@@ -21,6 +21,7 @@ var uninstallLong = lipgloss.JoinVertical(
 	"",
 	style.BoldUnderline("Overview:"),
 	"Uninstall Algorand node (Algod) and other binaries on your system installed by this tool.",
+	"A user service installed with *nodekit install --user* is removed with its binaries, its data directory is kept.",
 	"",
 	style.Yellow.Render("This requires the daemon to be installed on your system."),
 )
@@ -36,8 +37,10 @@ var uninstallCmd = &cobra.Command{
 		if force {
 			log.Warn(style.Red.Render("Uninstalling Algorand (forcefully)"))
 		}
-		// Warn user for prompt
-		log.Warn(style.Yellow.Render(UninstallWarningMsg))
+		// Warn user for prompt, a user service needs no password
+		if !algod.IsUserService() {
+			log.Warn(style.Yellow.Render(UninstallWarningMsg))
+		}
 
 		err := algod.Uninstall(force)
 		if err != nil {
@@ -38,3 +38,16 @@ func WithAlgodFlags(cmd *cobra.Command, algodData *string) *cobra.Command {
 
 	return cmd
 }
+
+// WithFleetFlags enhances a cobra.Command with flags for watching one or more nodes.
+func WithFleetFlags(cmd *cobra.Command, algodData *[]string, fleetConfig *string) *cobra.Command {
+	cmd.Flags().StringArrayVarP(algodData, "datadir", "d", nil, style.LightBlue("Data directory for the node, can be repeated to watch a fleet"))
+	cmd.Flags().StringVar(fleetConfig, "fleet", "", style.LightBlue("Path of a fleet config listing the data directories of the nodes to watch"))
+
+	if viper.GetString("datadir") != "" {
+		cmd.Long +=
+			style.LightBlue("  Data: ") + viper.GetString("datadir") + "\n"
+	}
+
+	return cmd
+}
@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/history"
+	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/spf13/cobra"
+)
+
+// History holds the options for recording the node history.
+var History struct {
+	// Record enables recording the watched nodes
+	Record bool
+	// File is the path of the history file, defaults to history.DefaultPath
+	File string
+}
+
+// WithHistoryFlags adds the --record and --history-file flags to a command and all of its subcommands.
+func WithHistoryFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.PersistentFlags().BoolVar(&History.Record, "record", false, style.LightBlue("Record rounds, state changes, accounts and keys of the watched nodes to the history file"))
+	cmd.PersistentFlags().StringVar(&History.File, "history-file", "", style.LightBlue("Path of the history file, defaults to ~/.nodekit/"+history.DefaultFilename))
+	return cmd
+}
+
+// OpenHistory returns the store of the history file.
+func OpenHistory() (*history.Store, error) {
+	path := History.File
+	if path == "" {
+		var err error
+		path, err = history.DefaultPath()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return history.Open(path)
+}
+
+// NodeName identifies the node in the history, the remote endpoint or the resolved data directory.
+func NodeName(dataDir string) string {
+	if IsRemote() {
+		return Remote.Endpoint
+	}
+	if dir, err := algod.GetDataDir(dataDir); err == nil {
+		return dir
+	}
+	return dataDir
+}
+
+// AppendHistory writes records of actions taken by a command when --record is set.
+func AppendHistory(records ...history.Record) error {
+	if !History.Record {
+		return nil
+	}
+	store, err := OpenHistory()
+	if err != nil {
+		return err
+	}
+	return store.Append(records...)
+}
+
+// RecordHistory records the events of the state when --record is set.
+// It must be called before the state is watched and returns the function to stop recording.
+func RecordHistory(state *algod.StateModel, node string) (func(), error) {
+	if !History.Record {
+		return func() {}, nil
+	}
+	store, err := OpenHistory()
+	if err != nil {
+		return nil, err
+	}
+	events, unsubscribe := state.Subscribe()
+	recorder := history.Recorder{Store: store, Node: node}
+	go func() {
+		// The recorder logs the errors of the store and keeps recording
+		_ = recorder.Run(events, new(system.Clock))
+	}()
+	return unsubscribe, nil
+}
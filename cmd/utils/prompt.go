@@ -1,9 +1,14 @@
 package utils
 
 import (
+	"fmt"
+	"os"
+	"strings"
+
 	"github.com/algorandfoundation/nodekit/ui/prompt"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/log"
+	"golang.org/x/term"
 )
 
 func Prompt(message string) bool {
@@ -24,3 +29,14 @@ func Prompt(message string) bool {
 
 	return answer
 }
+
+// PromptSecret asks for a value on the terminal without echoing it.
+func PromptSecret(message string) (string, error) {
+	fmt.Fprint(os.Stderr, message+": ")
+	secret, err := term.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(secret)), nil
+}
@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/spf13/cobra"
+)
+
+// remoteAnnotation marks the commands that can manage a remote node.
+const remoteAnnotation = "nodekit/remote"
+
+// Remote holds the options for managing a node outside of a local data directory.
+var Remote struct {
+	// Endpoint of the algod REST API, enables the remote mode
+	Endpoint string
+	// Token is the admin token of the node
+	Token string
+	// TokenFile is the path of a file holding the admin token
+	TokenFile string
+}
+
+// WithRemoteFlags adds the --endpoint, --token and --token-file flags to a command and all of its subcommands.
+// Commands not marked with WithRemote are rejected when --endpoint is set.
+func WithRemoteFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.PersistentFlags().StringVar(&Remote.Endpoint, "endpoint", "", style.LightBlue("Algod REST API of a remote node, like http://10.0.0.2:8080"))
+	cmd.PersistentFlags().StringVar(&Remote.Token, "token", "", style.LightBlue("Admin token of the remote node, defaults to the "+algod.TokenEnv+" environment variable"))
+	cmd.PersistentFlags().StringVar(&Remote.TokenFile, "token-file", "", style.LightBlue("Path of a file holding the admin token of the remote node"))
+	cmd.PersistentPreRunE = RequireLocal
+	return cmd
+}
+
+// WithRemote marks a command and its subcommands as able to manage a remote node.
+func WithRemote(cmd *cobra.Command) *cobra.Command {
+	if cmd.Annotations == nil {
+		cmd.Annotations = make(map[string]string)
+	}
+	cmd.Annotations[remoteAnnotation] = "true"
+	return cmd
+}
+
+// IsRemote checks if the commands target a remote node.
+func IsRemote() bool {
+	return Remote.Endpoint != ""
+}
+
+// SupportsRemote checks if the command or one of its parent groups was marked with WithRemote.
+// The root command only marks itself.
+func SupportsRemote(cmd *cobra.Command) bool {
+	if cmd.Annotations[remoteAnnotation] != "" {
+		return true
+	}
+	for parent := cmd.Parent(); parent != nil && parent.HasParent(); parent = parent.Parent() {
+		if parent.Annotations[remoteAnnotation] != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// RequireLocal rejects the commands that only manage the local node when --endpoint is set.
+func RequireLocal(cmd *cobra.Command, args []string) error {
+	if IsRemote() && !SupportsRemote(cmd) {
+		return fmt.Errorf("%s manages the local node and is not available with --endpoint", cmd.CommandPath())
+	}
+	return nil
+}
+
+// GetClient builds the client of the remote node when --endpoint is set, otherwise of the node in the data directory.
+// It returns the resolved data directory, which is empty for a remote node.
+func GetClient(dataDir string) (*api.ClientWithResponses, string, error) {
+	if IsRemote() {
+		token, err := algod.ResolveToken(Remote.Token, Remote.TokenFile)
+		if err != nil {
+			return nil, "", err
+		}
+		client, err := algod.NewClient(Remote.Endpoint, token)
+		return client, "", err
+	}
+	dir, err := algod.GetDataDir(dataDir)
+	if err != nil {
+		return nil, "", err
+	}
+	client, err := algod.GetClient(dir)
+	return client, dir, err
+}
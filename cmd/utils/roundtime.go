@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"sync"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/charmbracelet/log"
+)
+
+// roundTimeMu serializes the updates of the round time file between the nodes of a fleet.
+var roundTimeMu sync.Mutex
+
+// LoadRoundTime sets the long-term average round time of the network of the state.
+// The averages are optional, errors are logged and the estimate starts from the protocol target.
+func LoadRoundTime(state *algod.StateModel) {
+	path, err := algod.DefaultRoundTimePath()
+	if err != nil {
+		log.Warnf("Unable to find the round times: %s", err)
+		return
+	}
+	averages, err := algod.LoadRoundTimeAverages(path)
+	if err != nil {
+		log.Warnf("Unable to read the round times: %s", err)
+		return
+	}
+	state.RoundTimeAverage = averages[state.Status.Network]
+}
+
+// TrackRoundTime loads the long-term average round time of the state and saves it every time the metrics are updated.
+// It must be called before the state is watched and returns the function to stop tracking.
+func TrackRoundTime(state *algod.StateModel) func() {
+	LoadRoundTime(state)
+	path, err := algod.DefaultRoundTimePath()
+	if err != nil {
+		return func() {}
+	}
+	network := state.Status.Network
+	events, unsubscribe := state.Subscribe()
+	go func() {
+		for event := range events {
+			if event.Type != algod.MetricsUpdatedEvent {
+				continue
+			}
+			average := event.State.RoundTimeAverage
+			roundTimeMu.Lock()
+			averages, err := algod.LoadRoundTimeAverages(path)
+			if err == nil {
+				averages[network] = average
+				err = algod.SaveRoundTimeAverages(path, averages)
+			}
+			roundTimeMu.Unlock()
+			if err != nil {
+				log.Warnf("Unable to save the round times: %s", err)
+			}
+		}
+	}()
+	return unsubscribe
+}
@@ -0,0 +1,22 @@
+package utils
+
+import (
+	"github.com/algorandfoundation/nodekit/internal/watchlist"
+	"github.com/charmbracelet/log"
+)
+
+// WatchAddresses returns the addresses of the NodeKit watch list.
+// The watch list is optional, errors are logged and no address is watched.
+func WatchAddresses() []string {
+	path, err := watchlist.DefaultPath()
+	if err != nil {
+		log.Warnf("Unable to find the watch list: %s", err)
+		return nil
+	}
+	config, err := watchlist.Load(path)
+	if err != nil {
+		log.Warnf("Unable to read the watch list: %s", err)
+		return nil
+	}
+	return config.Addresses()
+}
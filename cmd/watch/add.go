@@ -0,0 +1,55 @@
+package watch
+
+import (
+	"github.com/algorandfoundation/nodekit/internal/watchlist"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+// label describes the added account
+var label string
+
+// addCmdShort provides a concise description of the "add" command.
+var addCmdShort = "Watch an account with keys on another node"
+
+// addCmdLong provides a detailed description of the "add" command.
+var addCmdLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(addCmdShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Adds the address to the watch list, adding it again updates its label.",
+)
+
+// addCmd adds an address to the watch list.
+var addCmd = &cobra.Command{
+	Use:          "add <address>",
+	Short:        addCmdShort,
+	Long:         addCmdLong,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path, config, err := load()
+		if err != nil {
+			return err
+		}
+		err = config.Add(args[0], label)
+		if err != nil {
+			return err
+		}
+		err = watchlist.Save(path, config)
+		if err != nil {
+			return err
+		}
+		log.Info(style.Green.Render("Watching " + args[0]))
+		return nil
+	},
+}
+
+func init() {
+	addCmd.Flags().StringVarP(&label, "label", "l", "", style.LightBlue("Describe the account, like the node its key lives on"))
+}
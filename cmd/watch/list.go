@@ -0,0 +1,53 @@
+package watch
+
+import (
+	"fmt"
+
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/spf13/cobra"
+)
+
+// listCmdShort provides a concise description of the "list" command.
+var listCmdShort = "List the watched accounts"
+
+// listCmdLong provides a detailed description of the "list" command.
+var listCmdLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(listCmdShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Prints the addresses of the watch list with their labels.",
+	"Use nodekit status to see their state on the network.",
+)
+
+// listCmd prints the watch list.
+var listCmd = &cobra.Command{
+	Use:          "list",
+	Short:        listCmdShort,
+	Long:         listCmdLong,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		_, config, err := load()
+		if err != nil {
+			return err
+		}
+		if len(config.Accounts) == 0 {
+			fmt.Fprintln(cmd.OutOrStdout(), "No watched accounts, add one with nodekit watch add <address>")
+			return nil
+		}
+		rows := make([][]string, 0, len(config.Accounts))
+		for _, acct := range config.Accounts {
+			rows = append(rows, []string{acct.Address, acct.Label})
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), table.New().
+			Border(lipgloss.NormalBorder()).
+			Headers("Address", "Label").
+			Rows(rows...).
+			String())
+		return nil
+	},
+}
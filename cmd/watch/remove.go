@@ -0,0 +1,49 @@
+package watch
+
+import (
+	"fmt"
+
+	"github.com/algorandfoundation/nodekit/internal/watchlist"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+// removeCmdShort provides a concise description of the "remove" command.
+var removeCmdShort = "Stop watching an account"
+
+// removeCmdLong provides a detailed description of the "remove" command.
+var removeCmdLong = lipgloss.JoinVertical(
+	lipgloss.Left,
+	style.Purple(style.BANNER),
+	"",
+	style.Bold(removeCmdShort),
+	"",
+	style.BoldUnderline("Overview:"),
+	"Removes the address from the watch list.",
+)
+
+// removeCmd removes an address from the watch list.
+var removeCmd = &cobra.Command{
+	Use:          "remove <address>",
+	Short:        removeCmdShort,
+	Long:         removeCmdLong,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path, config, err := load()
+		if err != nil {
+			return err
+		}
+		if !config.Remove(args[0]) {
+			return fmt.Errorf("%s is not watched", args[0])
+		}
+		err = watchlist.Save(path, config)
+		if err != nil {
+			return err
+		}
+		log.Info(style.Green.Render("Stopped watching " + args[0]))
+		return nil
+	},
+}
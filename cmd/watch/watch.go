@@ -0,0 +1,49 @@
+package watch
+
+import (
+	"github.com/algorandfoundation/nodekit/internal/watchlist"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// cmdShort provides a concise description of the "watch" command.
+	cmdShort = "Manage the watch-only accounts"
+
+	// cmdLong provides a detailed description of the "watch" command.
+	cmdLong = lipgloss.JoinVertical(
+		lipgloss.Left,
+		style.Purple(style.BANNER),
+		"",
+		style.Bold(cmdShort),
+		"",
+		style.BoldUnderline("Overview:"),
+		"Watch-only accounts have their participation keys on another node.",
+		"NodeKit tracks them alongside the accounts of the local keys, flagged as REMOTE.",
+	)
+
+	// Cmd represents the root command for managing the watch list.
+	Cmd = &cobra.Command{
+		Use:   "watch",
+		Short: cmdShort,
+		Long:  cmdLong,
+	}
+)
+
+// load returns the path and the accounts of the watch list,
+// the list read by the other commands in watchlist.DefaultPath.
+func load() (string, watchlist.Config, error) {
+	path, err := watchlist.DefaultPath()
+	if err != nil {
+		return "", watchlist.Config{}, err
+	}
+	config, err := watchlist.Load(path)
+	return path, config, err
+}
+
+func init() {
+	Cmd.AddCommand(addCmd)
+	Cmd.AddCommand(removeCmd)
+	Cmd.AddCommand(listCmd)
+}
@@ -0,0 +1,225 @@
+package alerts
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/test/mock"
+)
+
+// testClock is a clock that can be moved forward.
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time { return c.now }
+
+// testNotifier records every alert it receives.
+type testNotifier struct {
+	alerts []Alert
+	err    error
+}
+
+func (n *testNotifier) Notify(ctx context.Context, alert Alert) error {
+	n.alerts = append(n.alerts, alert)
+	return n.err
+}
+
+func getState() *algod.StateModel {
+	expires := time.Time{}.Add(time.Hour * 24)
+	return &algod.StateModel{
+		Status: algod.Status{State: algod.StableState, LastRound: 10},
+		Accounts: map[string]algod.Account{
+			"ABC": {
+				Address:           "ABC",
+				Status:            "Online",
+				IncentiveEligible: true,
+				Expires:           &expires,
+			},
+		},
+	}
+}
+
+func Test_KeyExpiryRule(t *testing.T) {
+	state := getState()
+	alerts := KeyExpiryRule{Within: time.Hour}.Evaluate(state, new(mock.Clock))
+	if len(alerts) != 0 {
+		t.Fatalf("expected no alerts, got %v", alerts)
+	}
+	alerts = KeyExpiryRule{Within: time.Hour * 48}.Evaluate(state, new(mock.Clock))
+	if len(alerts) != 1 || alerts[0].Severity != Warning || alerts[0].Address != "ABC" {
+		t.Fatalf("expected a warning for ABC, got %v", alerts)
+	}
+
+	// The earliest estimate of the expiry is within the duration
+	acct := state.Accounts["ABC"]
+	earliest, latest := time.Time{}.Add(time.Minute*30), time.Time{}.Add(time.Hour*48)
+	acct.ExpiresEarliest, acct.ExpiresLatest = &earliest, &latest
+	state.Accounts["ABC"] = acct
+	alerts = KeyExpiryRule{Within: time.Hour}.Evaluate(state, new(mock.Clock))
+	if len(alerts) != 1 || !strings.Contains(alerts[0].Message, "(between 01 Jan 01 00:30 UTC and 03 Jan 01 00:00 UTC)") {
+		t.Fatalf("expected a warning with the expiry range, got %v", alerts)
+	}
+}
+
+func Test_OfflineAndEligibilityRules(t *testing.T) {
+	state := getState()
+	offline := &OfflineRule{}
+	eligibility := &IncentiveEligibilityRule{}
+	if len(offline.Evaluate(state, new(mock.Clock))) != 0 || len(eligibility.Evaluate(state, new(mock.Clock))) != 0 {
+		t.Fatal("expected no alerts while online")
+	}
+
+	acct := state.Accounts["ABC"]
+	acct.Status = "Offline"
+	acct.IncentiveEligible = false
+	acct.Participation = &api.AccountParticipation{}
+	state.Accounts["ABC"] = acct
+
+	alerts := offline.Evaluate(state, new(mock.Clock))
+	if len(alerts) != 1 || alerts[0].Message != "ABC was suspended" {
+		t.Errorf("expected a suspended alert, got %v", alerts)
+	}
+	if len(eligibility.Evaluate(state, new(mock.Clock))) != 1 {
+		t.Error("expected an eligibility alert")
+	}
+
+	// Accounts never seen online do not alert
+	if len((&OfflineRule{}).Evaluate(state, new(mock.Clock))) != 0 {
+		t.Error("expected no alerts for a new rule")
+	}
+}
+
+func Test_StalledRule(t *testing.T) {
+	clock := &testClock{now: time.Unix(0, 0)}
+	state := getState()
+	// The estimate blends the measured round time with the protocol target, up to 1.94s
+	state.Metrics.RoundTime = time.Second
+	state.Metrics.Window = 100
+	rule := &StalledRule{Rounds: 5}
+	if len(rule.Evaluate(state, clock)) != 0 {
+		t.Fatal("expected no alert on the first evaluation")
+	}
+	clock.now = clock.now.Add(time.Second * 4)
+	if len(rule.Evaluate(state, clock)) != 0 {
+		t.Fatal("expected no alert before the stall threshold")
+	}
+	clock.now = clock.now.Add(time.Second * 6)
+	if len(rule.Evaluate(state, clock)) != 1 {
+		t.Fatal("expected a stall alert")
+	}
+	state.Status.LastRound++
+	if len(rule.Evaluate(state, clock)) != 0 {
+		t.Fatal("expected the alert to resolve on a new round")
+	}
+	state.Status.State = algod.DownState
+	if len(rule.Evaluate(state, clock)) != 1 {
+		t.Fatal("expected an alert when the node is down")
+	}
+}
+
+func Test_ManagerQuietPeriod(t *testing.T) {
+	clock := &testClock{now: time.Unix(0, 0)}
+	notifier := &testNotifier{}
+	state := getState()
+	state.Status.State = algod.DownState
+	manager := Manager{
+		Rules:       []Rule{&StalledRule{Rounds: 1}},
+		Notifiers:   []Notifier{notifier},
+		QuietPeriod: time.Hour,
+	}
+
+	for i := 0; i < 3; i++ {
+		_, err := manager.Evaluate(context.Background(), state, clock)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(notifier.alerts) != 1 {
+		t.Fatalf("expected one notification during the quiet period, got %d", len(notifier.alerts))
+	}
+
+	clock.now = clock.now.Add(time.Hour)
+	_, _ = manager.Evaluate(context.Background(), state, clock)
+	if len(notifier.alerts) != 2 {
+		t.Fatalf("expected a reminder after the quiet period, got %d", len(notifier.alerts))
+	}
+
+	// A resolved alert notifies again as soon as it comes back
+	state.Status.State = algod.StableState
+	_, _ = manager.Evaluate(context.Background(), state, clock)
+	state.Status.State = algod.DownState
+	_, _ = manager.Evaluate(context.Background(), state, clock)
+	if len(notifier.alerts) != 3 {
+		t.Fatalf("expected a new notification after resolving, got %d", len(notifier.alerts))
+	}
+
+	notifier.err = errors.New("failed")
+	clock.now = clock.now.Add(time.Hour)
+	_, err := manager.Evaluate(context.Background(), state, clock)
+	if err == nil {
+		t.Error("expected the notifier error")
+	}
+}
+
+func Test_WebhookNotifier(t *testing.T) {
+	var contentType string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	notifier := WebhookNotifier{URL: server.URL}
+	err := notifier.Notify(context.Background(), Alert{Rule: "test", Severity: Warning})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contentType != "application/json" {
+		t.Errorf("unexpected content type %s", contentType)
+	}
+
+	notifier.URL = server.URL + "/missing"
+	server.Config.Handler = http.NotFoundHandler()
+	if notifier.Notify(context.Background(), Alert{}) == nil {
+		t.Error("expected an error for a failed webhook")
+	}
+
+	// The request is cancelled with the context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := notifier.Notify(ctx, Alert{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the context error, got %v", err)
+	}
+}
+
+func Test_ResolveSMTPPassword(t *testing.T) {
+	t.Setenv(SMTPPasswordEnv, "from-env")
+	password, err := ResolveSMTPPassword("")
+	if err != nil || password != "from-env" {
+		t.Errorf("expected the environment variable, got %q, %v", password, err)
+	}
+
+	passwordFile := filepath.Join(t.TempDir(), "password")
+	if err := os.WriteFile(passwordFile, []byte("from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	password, err = ResolveSMTPPassword(passwordFile)
+	if err != nil || password != "from-file" {
+		t.Errorf("expected the file content, got %q, %v", password, err)
+	}
+
+	_, err = ResolveSMTPPassword(filepath.Join(t.TempDir(), "missing"))
+	if err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
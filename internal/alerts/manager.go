@@ -0,0 +1,72 @@
+package alerts
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/system"
+)
+
+// DefaultQuietPeriod is how long an active alert stays silent after it was notified.
+const DefaultQuietPeriod = time.Hour * 6
+
+// Manager evaluates Rules and sends the resulting alerts to every Notifier.
+// An alert is sent when it becomes active and again every QuietPeriod while it stays active.
+type Manager struct {
+	Rules       []Rule
+	Notifiers   []Notifier
+	QuietPeriod time.Duration
+
+	// lastSent tracks when each active alert was last notified, by Alert.Key
+	lastSent map[string]time.Time
+}
+
+// DefaultRules returns the rules used by the alerts command.
+func DefaultRules(expiry time.Duration, stallRounds int) []Rule {
+	return []Rule{
+		KeyExpiryRule{Within: expiry},
+		&OfflineRule{},
+		NonResidentKeyRule{},
+		&StalledRule{Rounds: stallRounds},
+		&IncentiveEligibilityRule{},
+	}
+}
+
+// Evaluate runs every rule against the state and notifies the alerts that are due.
+// It returns the alerts that were sent and the errors of any failed notifiers.
+func (m *Manager) Evaluate(ctx context.Context, state *algod.StateModel, t system.Time) ([]Alert, error) {
+	if m.lastSent == nil {
+		m.lastSent = make(map[string]time.Time)
+	}
+	now := t.Now()
+	active := make(map[string]bool)
+	var sent []Alert
+	var errs []error
+	for _, rule := range m.Rules {
+		for _, alert := range rule.Evaluate(state, t) {
+			key := alert.Key()
+			active[key] = true
+			last, ok := m.lastSent[key]
+			if ok && now.Sub(last) < m.QuietPeriod {
+				continue
+			}
+			m.lastSent[key] = now
+			sent = append(sent, alert)
+			for _, notifier := range m.Notifiers {
+				if err := notifier.Notify(ctx, alert); err != nil {
+					errs = append(errs, err)
+				}
+			}
+		}
+	}
+
+	// Resolved alerts are forgotten so they notify immediately if they come back
+	for key := range m.lastSent {
+		if !active[key] {
+			delete(m.lastSent, key)
+		}
+	}
+	return sent, errors.Join(errs...)
+}
@@ -0,0 +1,131 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/smtp"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// Notifier delivers an Alert to a sink.
+type Notifier interface {
+	Notify(ctx context.Context, alert Alert) error
+}
+
+// WebhookNotifier posts each alert as JSON to a URL.
+type WebhookNotifier struct {
+	URL string
+	// Client sends the requests, http.DefaultClient when nil
+	Client *http.Client
+}
+
+// Notify posts the alert to the webhook, the request is cancelled with the context.
+func (n WebhookNotifier) Notify(ctx context.Context, alert Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	client := n.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook %s responded with %s", n.URL, resp.Status)
+	}
+	return nil
+}
+
+// SMTPPasswordEnv is the environment variable holding the SMTP password.
+const SMTPPasswordEnv = "NODEKIT_SMTP_PASSWORD"
+
+// ResolveSMTPPassword returns the content of the password file, or the SMTPPasswordEnv environment variable without one.
+func ResolveSMTPPassword(passwordFile string) (string, error) {
+	if passwordFile == "" {
+		return os.Getenv(SMTPPasswordEnv), nil
+	}
+	data, err := os.ReadFile(passwordFile)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// SMTPNotifier emails each alert through an SMTP server.
+type SMTPNotifier struct {
+	// Addr of the SMTP server, including the port.
+	Addr string
+	From string
+	To   []string
+	// Username and Password are optional, PLAIN authentication is used when the username is set.
+	Username string
+	Password string
+}
+
+// Message formats the alert as an email.
+func (n SMTPNotifier) Message(alert Alert) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", n.From)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(n.To, ", "))
+	fmt.Fprintf(&b, "Subject: [nodekit] %s: %s\r\n", strings.ToUpper(string(alert.Severity)), alert.Rule)
+	fmt.Fprintf(&b, "Date: %s\r\n", alert.Time.Format(time.RFC1123Z))
+	b.WriteString("\r\n")
+	b.WriteString(alert.Message + "\r\n")
+	return b.Bytes()
+}
+
+// Notify sends the alert as an email.
+func (n SMTPNotifier) Notify(ctx context.Context, alert Alert) error {
+	var auth smtp.Auth
+	if n.Username != "" {
+		host := n.Addr
+		if i := strings.LastIndex(host, ":"); i != -1 {
+			host = host[:i]
+		}
+		auth = smtp.PlainAuth("", n.Username, n.Password, host)
+	}
+	return smtp.SendMail(n.Addr, auth, n.From, n.To, n.Message(alert))
+}
+
+// CommandNotifier runs a local command for each alert.
+// The alert is written to stdin as JSON and exposed through NODEKIT_ALERT_* environment variables.
+type CommandNotifier struct {
+	Command string
+	Args    []string
+}
+
+// Notify runs the command and waits for it to exit.
+func (n CommandNotifier) Notify(ctx context.Context, alert Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+	cmd := exec.CommandContext(ctx, n.Command, n.Args...)
+	cmd.Stdin = bytes.NewReader(body)
+	cmd.Env = append(os.Environ(),
+		"NODEKIT_ALERT_RULE="+alert.Rule,
+		"NODEKIT_ALERT_SEVERITY="+string(alert.Severity),
+		"NODEKIT_ALERT_ADDRESS="+alert.Address,
+		"NODEKIT_ALERT_MESSAGE="+alert.Message,
+	)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s failed: %w: %s", n.Command, err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+package alerts
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/system"
+)
+
+// Severity describes how urgent an Alert is.
+type Severity string
+
+const (
+	// Warning alerts need attention soon but consensus participation is not yet affected.
+	Warning Severity = "warning"
+
+	// Critical alerts mean an account is, or is about to stop, participating.
+	Critical Severity = "critical"
+)
+
+// Alert is a single problem found by a Rule.
+type Alert struct {
+	// Rule is the name of the Rule that raised the alert.
+	Rule string `json:"rule"`
+	// Severity of the alert.
+	Severity Severity `json:"severity"`
+	// Address of the account the alert is about, empty for node alerts.
+	Address string `json:"address,omitempty"`
+	// Message is a human-readable description of the problem.
+	Message string `json:"message"`
+	// Time the alert was raised.
+	Time time.Time `json:"time"`
+}
+
+// Key identifies an alert for de-duplication.
+func (a Alert) Key() string {
+	return a.Rule + "/" + a.Address
+}
+
+// Rule evaluates the StateModel and returns the alerts that are currently active.
+type Rule interface {
+	Name() string
+	Evaluate(state *algod.StateModel, t system.Time) []Alert
+}
+
+// KeyExpiryRule raises an alert when an online account's participation key expires within a duration.
+type KeyExpiryRule struct {
+	Within time.Duration
+}
+
+// Name of the rule.
+func (r KeyExpiryRule) Name() string {
+	return "key-expiry"
+}
+
+// Evaluate returns an alert for each online account with a key expiring within the duration.
+func (r KeyExpiryRule) Evaluate(state *algod.StateModel, t system.Time) []Alert {
+	var alerts []Alert
+	now := t.Now()
+	for _, acct := range state.Accounts {
+		if acct.Status != "Online" || acct.Expires == nil {
+			continue
+		}
+		// Alert on the earliest estimate, the round time can speed up before the key expires
+		earliest := acct.Expires
+		if acct.ExpiresEarliest != nil {
+			earliest = acct.ExpiresEarliest
+		}
+		remaining := earliest.Sub(now)
+		if remaining > r.Within {
+			continue
+		}
+		severity := Warning
+		if remaining <= 0 {
+			severity = Critical
+		}
+		alerts = append(alerts, Alert{
+			Rule:     r.Name(),
+			Severity: severity,
+			Address:  acct.Address,
+			Message:  expiryMessage(acct),
+			Time:     now,
+		})
+	}
+	return alerts
+}
+
+// expiryMessage describes when the participation key of the account expires, with the range of the estimate.
+func expiryMessage(acct algod.Account) string {
+	message := fmt.Sprintf("participation key for %s expires on %s", acct.Address, acct.Expires.Format(time.RFC822))
+	if acct.ExpiresEarliest != nil && acct.ExpiresLatest != nil && !acct.ExpiresEarliest.Equal(*acct.ExpiresLatest) {
+		message += fmt.Sprintf(" (between %s and %s)", acct.ExpiresEarliest.Format(time.RFC822), acct.ExpiresLatest.Format(time.RFC822))
+	}
+	return message
+}
+
+// OfflineRule raises an alert when an account that was online goes offline or is suspended.
+// It remembers which accounts were online, so the same rule must be reused between evaluations.
+type OfflineRule struct {
+	wasOnline map[string]bool
+}
+
+// Name of the rule.
+func (r *OfflineRule) Name() string {
+	return "account-offline"
+}
+
+// Evaluate returns an alert for each account that was online during a previous evaluation and is no longer.
+func (r *OfflineRule) Evaluate(state *algod.StateModel, t system.Time) []Alert {
+	var alerts []Alert
+	if r.wasOnline == nil {
+		r.wasOnline = make(map[string]bool)
+	}
+	for _, acct := range state.Accounts {
+		if acct.Status == "Online" {
+			r.wasOnline[acct.Address] = true
+			continue
+		}
+		// Accounts are "Unknown" until they are fetched from the node
+		if acct.Status == "Unknown" || !r.wasOnline[acct.Address] {
+			continue
+		}
+		message := fmt.Sprintf("%s went offline", acct.Address)
+		// An offline account that still has participation data was suspended by the protocol
+		if acct.Participation != nil {
+			message = fmt.Sprintf("%s was suspended", acct.Address)
+		}
+		alerts = append(alerts, Alert{
+			Rule:     r.Name(),
+			Severity: Critical,
+			Address:  acct.Address,
+			Message:  message,
+			Time:     t.Now(),
+		})
+	}
+	return alerts
+}
+
+// NonResidentKeyRule raises an alert when an online account's registered key is not on this node.
+type NonResidentKeyRule struct{}
+
+// Name of the rule.
+func (r NonResidentKeyRule) Name() string {
+	return "non-resident-key"
+}
+
+// Evaluate returns an alert for each account with a non-resident key.
+func (r NonResidentKeyRule) Evaluate(state *algod.StateModel, t system.Time) []Alert {
+	var alerts []Alert
+	for _, acct := range state.Accounts {
+		if !acct.NonResidentKey {
+			continue
+		}
+		alerts = append(alerts, Alert{
+			Rule:     r.Name(),
+			Severity: Warning,
+			Address:  acct.Address,
+			Message:  fmt.Sprintf("%s is online with a participation key that is not on this node", acct.Address),
+			Time:     t.Now(),
+		})
+	}
+	return alerts
+}
+
+// StalledRule raises an alert when the node is down or has not seen a new round for a number of rounds.
+// It keeps the last round it saw, so the same rule must be reused between evaluations.
+type StalledRule struct {
+	Rounds int
+
+	lastRound uint64
+	lastSeen  time.Time
+}
+
+// Name of the rule.
+func (r *StalledRule) Name() string {
+	return "node-stalled"
+}
+
+// Evaluate returns an alert when the node is down or stalled.
+func (r *StalledRule) Evaluate(state *algod.StateModel, t system.Time) []Alert {
+	now := t.Now()
+	if state.Status.State == algod.DownState {
+		return []Alert{{
+			Rule:     r.Name(),
+			Severity: Critical,
+			Message:  "node is not responding",
+			Time:     now,
+		}}
+	}
+	if state.Status.LastRound != r.lastRound || r.lastSeen.IsZero() {
+		r.lastRound = state.Status.LastRound
+		r.lastSeen = now
+		return nil
+	}
+
+	// The slowest estimate avoids alerting on rounds that are only slower than usual
+	roundTime := state.RoundTime().Max
+	if now.Sub(r.lastSeen) < roundTime*time.Duration(r.Rounds) {
+		return nil
+	}
+	return []Alert{{
+		Rule:     r.Name(),
+		Severity: Critical,
+		Message:  fmt.Sprintf("node has been stuck at round %d since %s", r.lastRound, r.lastSeen.Format(time.RFC822)),
+		Time:     now,
+	}}
+}
+
+// IncentiveEligibilityRule raises an alert when an account loses its incentive eligibility.
+// It remembers which accounts were eligible, so the same rule must be reused between evaluations.
+type IncentiveEligibilityRule struct {
+	wasEligible map[string]bool
+}
+
+// Name of the rule.
+func (r *IncentiveEligibilityRule) Name() string {
+	return "incentive-eligibility"
+}
+
+// Evaluate returns an alert for each account that was eligible during a previous evaluation and is no longer.
+func (r *IncentiveEligibilityRule) Evaluate(state *algod.StateModel, t system.Time) []Alert {
+	var alerts []Alert
+	if r.wasEligible == nil {
+		r.wasEligible = make(map[string]bool)
+	}
+	for _, acct := range state.Accounts {
+		if acct.IncentiveEligible {
+			r.wasEligible[acct.Address] = true
+			continue
+		}
+		// Accounts are "Unknown" until they are fetched from the node
+		if acct.Status == "Unknown" || !r.wasEligible[acct.Address] {
+			continue
+		}
+		alerts = append(alerts, Alert{
+			Rule:     r.Name(),
+			Severity: Warning,
+			Address:  acct.Address,
+			Message:  fmt.Sprintf("%s is no longer eligible for incentives", acct.Address),
+			Time:     t.Now(),
+		})
+	}
+	return alerts
+}
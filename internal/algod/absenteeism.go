@@ -0,0 +1,89 @@
+package algod
+
+import (
+	"math"
+)
+
+// AbsentFactor is how many expected proposal intervals an account can go unseen before the protocol suspends it.
+const AbsentFactor = 10
+
+// AbsenceWarning is the share of the absent threshold after which an account is at risk of suspension.
+const AbsenceWarning = 0.5
+
+// Absenteeism estimates how close an online account is to being suspended for not proposing blocks.
+// The protocol expects an account to propose about once per online stake divided by its stake rounds,
+// and suspends incentive eligible accounts it has not seen for AbsentFactor times that interval.
+type Absenteeism struct {
+	// LastSeen is the last round the account proposed a block or sent a heartbeat
+	LastSeen uint64
+	// Interval is the expected number of rounds between two proposals of the account
+	Interval uint64
+	// Threshold is the number of rounds without being seen after which the account is absent
+	Threshold uint64
+	// Rounds is the number of rounds since the account was last seen
+	Rounds uint64
+}
+
+// GetAbsenteeism estimates the absenteeism of the account at the round.
+// It returns false when the account cannot be suspended, like offline or ineligible accounts,
+// or when it is unknown, like accounts that were never seen or a missing online stake.
+func GetAbsenteeism(acct Account, onlineMoney int, round uint64) (Absenteeism, bool) {
+	var absenteeism Absenteeism
+	// Only incentive eligible accounts are suspended
+	if acct.Status != "Online" || !acct.IncentiveEligible {
+		return absenteeism, false
+	}
+	stake := float64(acct.Balance) * 1000000
+	absenteeism.LastSeen = uint64(max(acct.LastProposed, acct.LastHeartbeat))
+	if stake == 0 || onlineMoney <= 0 || absenteeism.LastSeen == 0 {
+		return absenteeism, false
+	}
+
+	interval := float64(onlineMoney) / stake
+	// Accounts with a tiny stake are never considered absent by the protocol
+	if interval*AbsentFactor > math.MaxUint32 {
+		return absenteeism, false
+	}
+	absenteeism.Interval = uint64(math.Ceil(interval))
+	absenteeism.Threshold = uint64(math.Ceil(interval * AbsentFactor))
+	if round > absenteeism.LastSeen {
+		absenteeism.Rounds = round - absenteeism.LastSeen
+	}
+	return absenteeism, true
+}
+
+// Risk is the share of the absent threshold used since the account was last seen, 1 or more is absent.
+func (a Absenteeism) Risk() float64 {
+	if a.Threshold == 0 {
+		return 0
+	}
+	return float64(a.Rounds) / float64(a.Threshold)
+}
+
+// AtRisk checks if the account passed the AbsenceWarning share of the absent threshold.
+func (a Absenteeism) AtRisk() bool {
+	return a.Risk() >= AbsenceWarning
+}
+
+// Absent checks if the account went unseen for longer than the threshold and can be suspended.
+func (a Absenteeism) Absent() bool {
+	return a.Threshold > 0 && a.Rounds > a.Threshold
+}
+
+// Remaining is the number of rounds left before the account is absent.
+func (a Absenteeism) Remaining() uint64 {
+	if a.Rounds >= a.Threshold {
+		return 0
+	}
+	return a.Threshold - a.Rounds
+}
+
+// Absenteeism estimates the absenteeism of an account of the node at the last round,
+// using the online stake of the network fetched while tracking the Performance.
+func (s *StateModel) Absenteeism(address string) (Absenteeism, bool) {
+	acct, ok := s.Accounts[address]
+	if !ok {
+		return Absenteeism{}, false
+	}
+	return GetAbsenteeism(acct, s.Performance.OnlineMoney, s.Status.LastRound)
+}
@@ -0,0 +1,61 @@
+package algod
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Absenteeism(t *testing.T) {
+	// A tenth of the online stake expects a proposal every 10 rounds
+	acct := Account{Address: "ABC", Status: "Online", IncentiveEligible: true, Balance: 1000000, LastProposed: 1000, LastHeartbeat: 900}
+	onlineMoney := 10000000000000
+
+	absenteeism, ok := GetAbsenteeism(acct, onlineMoney, 1040)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1000), absenteeism.LastSeen)
+	assert.Equal(t, uint64(10), absenteeism.Interval)
+	assert.Equal(t, uint64(100), absenteeism.Threshold)
+	assert.Equal(t, uint64(40), absenteeism.Rounds)
+	assert.InDelta(t, 0.4, absenteeism.Risk(), 0.0001)
+	assert.False(t, absenteeism.AtRisk())
+	assert.False(t, absenteeism.Absent())
+	assert.Equal(t, uint64(60), absenteeism.Remaining())
+
+	// A heartbeat after the last proposal counts as seen
+	acct.LastHeartbeat = 1030
+	absenteeism, _ = GetAbsenteeism(acct, onlineMoney, 1090)
+	assert.Equal(t, uint64(60), absenteeism.Rounds)
+	assert.True(t, absenteeism.AtRisk())
+	assert.False(t, absenteeism.Absent())
+
+	absenteeism, _ = GetAbsenteeism(acct, onlineMoney, 1200)
+	assert.True(t, absenteeism.Absent())
+	assert.Equal(t, uint64(0), absenteeism.Remaining())
+
+	// Accounts that cannot be suspended
+	for name, acct := range map[string]Account{
+		"offline":    {Status: "Offline", IncentiveEligible: true, Balance: 1000000, LastProposed: 1000},
+		"ineligible": {Status: "Online", IncentiveEligible: false, Balance: 1000000, LastProposed: 1000},
+		"unseen":     {Status: "Online", IncentiveEligible: true, Balance: 1000000},
+		"empty":      {Status: "Online", IncentiveEligible: true, LastProposed: 1000},
+		"tiny":       {Status: "Online", IncentiveEligible: true, Balance: 1, LastProposed: 1000},
+	} {
+		_, ok = GetAbsenteeism(acct, onlineMoney*1000, 1040)
+		assert.False(t, ok, name)
+	}
+	_, ok = GetAbsenteeism(acct, 0, 1040)
+	assert.False(t, ok)
+
+	state := StateModel{
+		Status:      Status{LastRound: 1090},
+		Accounts:    map[string]Account{"ABC": acct},
+		Performance: Performance{OnlineMoney: onlineMoney},
+	}
+	absenteeism, ok = state.Absenteeism("ABC")
+	assert.True(t, ok)
+	assert.True(t, absenteeism.AtRisk())
+	_, ok = state.Absenteeism("MISSING")
+	assert.False(t, ok)
+	assert.Equal(t, 0.0, Absenteeism{}.Risk())
+}
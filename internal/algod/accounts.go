@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/algorandfoundation/nodekit/internal/algod/participation"
-	"github.com/algorandfoundation/nodekit/internal/algod/utils"
 	"github.com/algorandfoundation/nodekit/internal/system"
 
 	"github.com/algorand/go-algorand-sdk/v2/types"
@@ -21,6 +20,8 @@ type Account struct {
 	IncentiveEligible bool
 	// NonResidentKey finds an online account that is missing locally
 	NonResidentKey bool
+	// WatchOnly is an account without participation keys on this node, watched from the NodeKit watch list
+	WatchOnly bool
 	// Account Address is the algorand encoded address
 	Address string
 	// Status is the Online/Offline/"NotParticipating" status of the account
@@ -33,13 +34,20 @@ type Account struct {
 	Keys int
 	// Expires is the date the participation key will expire
 	Expires *time.Time
+	// ExpiresEarliest and ExpiresLatest are the confidence range of Expires
+	ExpiresEarliest *time.Time
+	ExpiresLatest   *time.Time
+	// LastProposed is the last round the account proposed a block, zero when it never did
+	LastProposed int
+	// LastHeartbeat is the last round the account went online or sent a heartbeat, zero when unknown
+	LastHeartbeat int
 }
 
 // GetAccount status of api.Account
-func GetAccount(client api.ClientWithResponsesInterface, address string) (api.Account, error) {
+func GetAccount(ctx context.Context, client api.ClientWithResponsesInterface, address string) (api.Account, error) {
 	var format api.AccountInformationParamsFormat = "json"
 	r, err := client.AccountInformationWithResponse(
-		context.Background(),
+		ctx,
 		address,
 		&api.AccountInformationParams{
 			Format: &format,
@@ -91,6 +99,23 @@ func ParticipationKeysToAccounts(keys []api.ParticipationKey) map[string]Account
 	return accounts
 }
 
+// AddWatchAccounts adds the watched addresses missing from the accounts as WatchOnly accounts.
+// Addresses with participation keys on the node stay local accounts.
+func AddWatchAccounts(accounts map[string]Account, addresses []string) map[string]Account {
+	for _, address := range addresses {
+		if _, ok := accounts[address]; ok {
+			continue
+		}
+		accounts[address] = Account{
+			Address:   address,
+			Status:    "Unknown",
+			Keys:      0,
+			WatchOnly: true,
+		}
+	}
+	return accounts
+}
+
 // Merge updates the Account instance with data from the provided api.Account and returns the updated Account.
 // It updates fields such as Status, Balance, Participation, and IncentiveEligible based on the rpcAccount values.
 func (a Account) Merge(rpcAccount api.Account) Account {
@@ -107,6 +132,13 @@ func (a Account) Merge(rpcAccount api.Account) Account {
 
 	a.IncentiveEligible = incentiveEligible
 
+	if rpcAccount.LastProposed != nil {
+		a.LastProposed = *rpcAccount.LastProposed
+	}
+	if rpcAccount.LastHeartbeat != nil {
+		a.LastHeartbeat = *rpcAccount.LastHeartbeat
+	}
+
 	if rpcAccount.Participation != nil {
 		a.Participation = rpcAccount.Participation
 	}
@@ -114,19 +146,25 @@ func (a Account) Merge(rpcAccount api.Account) Account {
 	return a
 }
 
-// GetExpiresTime calculates the expiration time of the account's participation key based on round differences and duration.
-// Returns nil if the account has no participation or if the expiration time cannot be determined.
-func (a Account) GetExpiresTime(t system.Time, lastRound int, roundTime time.Duration) *time.Time {
+// ShouldAddIncentivesFee checks if an online keyreg for the account should pay the incentive eligibility fee.
+// The fee is added unless incentives are disabled by the user or the account is already eligible.
+func (a Account) ShouldAddIncentivesFee(incentivesDisabled bool) bool {
+	return !incentivesDisabled && !a.IncentiveEligible
+}
+
+// GetExpiresTime estimates the expiration of the account's participation key from the round time estimate.
+// Returns false if the account has no participation or if the expiration time cannot be determined.
+func (a Account) GetExpiresTime(t system.Time, lastRound int, roundTime RoundTimeEstimate) (Expiry, bool) {
 	if a.Participation == nil {
-		return nil
+		return Expiry{}, false
 	}
-	return utils.GetExpiresTime(t, lastRound, roundTime, a.Participation.VoteLastValid)
+	return roundTime.Expires(t, lastRound, a.Participation.VoteLastValid)
 }
 
 // UpdateExpiredTime updates the account's expiration time and identifies if the account has a non-resident participation key.
 // It checks if the account is offline or if its local participation key matches one of the provided keys.
 // The method recalculates the expiration time based on the last round and round duration.
-func (a Account) UpdateExpiredTime(t system.Time, keys []api.ParticipationKey, lastRound int, roundTime time.Duration) Account {
+func (a Account) UpdateExpiredTime(t system.Time, keys []api.ParticipationKey, lastRound int, roundTime RoundTimeEstimate) Account {
 	var nonResidentKey = true
 	for _, key := range keys {
 		// We have the key locally, update the residency
@@ -134,8 +172,12 @@ func (a Account) UpdateExpiredTime(t system.Time, keys []api.ParticipationKey, l
 			nonResidentKey = false
 		}
 	}
-	a.NonResidentKey = nonResidentKey
-	a.Expires = a.GetExpiresTime(t, lastRound, roundTime)
+	// The keys of watched accounts are expected to live on another node
+	a.NonResidentKey = nonResidentKey && !a.WatchOnly
+	a.Expires, a.ExpiresEarliest, a.ExpiresLatest = nil, nil, nil
+	if expiry, ok := a.GetExpiresTime(t, lastRound, roundTime); ok {
+		a.Expires, a.ExpiresEarliest, a.ExpiresLatest = &expiry.Time, &expiry.Earliest, &expiry.Latest
+	}
 	return a
 }
 
@@ -149,6 +191,25 @@ func (a Account) PatchOnlineStatus(acct api.Account, lastRound int) Account {
 	return a
 }
 
+// DuplicateKeys finds the accounts whose registered participation key is installed on more than one node.
+// It returns the indexes of the states of those nodes by address.
+func DuplicateKeys(states []*StateModel) map[string][]int {
+	nodes := make(map[string][]int)
+	for i, state := range states {
+		for address, acct := range state.Accounts {
+			if FindRegisteredKey(state.ParticipationKeys, acct) != nil {
+				nodes[address] = append(nodes[address], i)
+			}
+		}
+	}
+	for address, indexes := range nodes {
+		if len(indexes) < 2 {
+			delete(nodes, address)
+		}
+	}
+	return nodes
+}
+
 // ValidateAddress checks the validity of an Algorand address by decoding it. Returns true for valid addresses, false otherwise.
 func ValidateAddress(address string) bool {
 	_, err := types.DecodeAddress(address)
@@ -30,7 +30,7 @@ func Test_AccountsFromState(t *testing.T) {
 	var mapAccounts = make(map[string]api.Account)
 	var onlineAccounts = make([]api.Account, 0)
 	for _, address := range addresses {
-		acct, err := GetAccount(client, address)
+		acct, err := GetAccount(context.Background(), client, address)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -42,7 +42,7 @@ func Test_AccountsFromState(t *testing.T) {
 		}
 	}
 
-	acct, err := GetAccount(client, rewardsPool)
+	acct, err := GetAccount(context.Background(), client, rewardsPool)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -50,7 +50,7 @@ func Test_AccountsFromState(t *testing.T) {
 		t.Fatalf("Expected RewardsPool to be 'Not Participating', got %s", acct.Status)
 	}
 
-	acct, err = GetAccount(client, feeSink)
+	acct, err = GetAccount(context.Background(), client, feeSink)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -58,7 +58,7 @@ func Test_AccountsFromState(t *testing.T) {
 		t.Fatalf("Expected FeeSink to be 'Not Participating', got %s", acct.Status)
 	}
 
-	_, err = GetAccount(client, "invalid_address")
+	_, err = GetAccount(context.Background(), client, "invalid_address")
 	if err == nil {
 		t.Fatal("Expected error for invalid address")
 	}
@@ -99,3 +99,34 @@ func Test_AccountsFromState(t *testing.T) {
 	state.UpdateKeys(context.Background(), clock)
 
 }
+
+func Test_WatchAccounts(t *testing.T) {
+	watched := "QNZ7GONNHTNXFW56Y24CNJQEMYKZKKI566ASNSWPD24VSGKJWHGO6QOP7U"
+	accounts := AddWatchAccounts(ParticipationKeysToAccounts(mock.Keys), []string{watched, "ABC"})
+	assert.Len(t, accounts, 3)
+	assert.True(t, accounts[watched].WatchOnly)
+	assert.Equal(t, 0, accounts[watched].Keys)
+	// Local accounts are not replaced
+	assert.False(t, accounts["ABC"].WatchOnly)
+
+	// Watched accounts are fetched with the local ones
+	client := test.GetClient(false)
+	state := StateModel{
+		Status:        Status{LastRound: 100, Client: client},
+		Metrics:       Metrics{RoundTime: 2 * time.Second, Client: client},
+		Client:        client,
+		WatchAccounts: []string{watched},
+	}
+	state.UpdateKeys(context.Background(), new(mock.Clock))
+	acct := state.Accounts[watched]
+	assert.True(t, acct.WatchOnly)
+	assert.Equal(t, "Online", acct.Status)
+	assert.False(t, acct.NonResidentKey)
+
+	// The registered key of ABC is on both nodes
+	other := StateModel{Accounts: state.Accounts, ParticipationKeys: state.ParticipationKeys}
+	offline := StateModel{Accounts: map[string]Account{"ABC": {Address: "ABC", Status: "Offline"}}}
+	duplicates := DuplicateKeys([]*StateModel{&state, &offline, &other})
+	assert.Equal(t, map[string][]int{"ABC": {0, 2}}, duplicates)
+	assert.Empty(t, DuplicateKeys([]*StateModel{&state, &offline}))
+}
@@ -23,7 +23,7 @@ const InvalidVersionResponseError = "invalid version response"
 // IsInstalled checks if the Algod software is installed on the system
 // by verifying its presence and service setup.
 func IsInstalled() bool {
-	return system.CmdExists("algod")
+	return system.CmdExists("algod") || IsUserService()
 }
 
 // IsRunning checks the algod PID file and if it's currenlty running on the host operating system.
@@ -77,6 +77,12 @@ func IsService() bool {
 	}
 }
 
+// IsUserService determines if the Algorand service runs in the systemd
+// instance of the user instead of the system, only supported on Linux.
+func IsUserService() bool {
+	return runtime.GOOS == "linux" && linux.IsUserService()
+}
+
 // SetNetwork configures the network to the specified setting
 // or returns an error on unsupported operating systems.
 func SetNetwork(network string) error {
@@ -96,6 +102,17 @@ func Install() error {
 	}
 }
 
+// InstallUser installs Algorand as a service of the current user without root access,
+// with linger the service keeps running after the user logs out. Only supported on Linux.
+func InstallUser(linger bool) error {
+	switch runtime.GOOS {
+	case "linux":
+		return linux.InstallUser(linger)
+	default:
+		return fmt.Errorf(UnsupportedOSError)
+	}
+}
+
 // Update checks the operating system and performs an
 // upgrade using OS-specific package managers, if supported.
 func Update() error {
@@ -135,6 +152,17 @@ func UpdateService(dataDirectoryPath string) error {
 	}
 }
 
+// ReloadService reloads the service configuration after its files were
+// restored, only systemd needs to reload the files of a service.
+func ReloadService() error {
+	switch runtime.GOOS {
+	case "linux":
+		return linux.ReloadService()
+	default:
+		return nil
+	}
+}
+
 // EnsureService ensures the `algod` service is configured and running
 // as a service based on the OS;
 // Returns an error for unsupported systems.
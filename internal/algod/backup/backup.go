@@ -0,0 +1,309 @@
+package backup
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/system"
+)
+
+// Dirname is the directory of the data directory holding the previous versions of the configuration files.
+const Dirname = "nodekit-history"
+
+// versionFilename describes the files saved in a version directory.
+const versionFilename = "version.json"
+
+// MaxVersions is the number of versions kept, the oldest versions are removed first.
+const MaxVersions = 50
+
+// ErrNotFound is returned for a version that does not exist or is incomplete.
+var ErrNotFound = errors.New("not found")
+
+// File is a configuration file saved in a version.
+type File struct {
+	// Path is the location of the file that was changed
+	Path string `json:"path"`
+	// Exists is false when the file did not exist before the change
+	Exists bool `json:"exists"`
+}
+
+// Version is the content of the configuration files before a NodeKit command changed them.
+type Version struct {
+	ID      int       `json:"id"`
+	Time    time.Time `json:"time"`
+	Command string    `json:"command"`
+	Files   []File    `json:"files"`
+}
+
+// Dir returns the history directory of a data directory.
+func Dir(dataDir string) string {
+	return filepath.Join(dataDir, Dirname)
+}
+
+// versionDir returns the directory of a version.
+func versionDir(dataDir string, id int) string {
+	return filepath.Join(Dir(dataDir), strconv.Itoa(id))
+}
+
+// savedPath returns the location of the copy of a file in the directory of a version.
+func savedPath(dir string, index int, path string) string {
+	return filepath.Join(dir, fmt.Sprintf("%d-%s", index, filepath.Base(path)))
+}
+
+// commandLine returns the command that is running, the values of token flags are hidden.
+func commandLine() string {
+	if len(os.Args) == 0 {
+		return ""
+	}
+	args := []string{filepath.Base(os.Args[0])}
+	hide := false
+	for _, arg := range os.Args[1:] {
+		switch {
+		case hide:
+			arg = "***"
+			hide = false
+		case arg == "--token":
+			hide = true
+		case strings.HasPrefix(arg, "--token="):
+			arg = "--token=***"
+		}
+		args = append(args, arg)
+	}
+	return strings.Join(args, " ")
+}
+
+// writeFile writes a file of the history, owned like its parent directory when running as root.
+func writeFile(path string, data []byte) error {
+	err := os.WriteFile(path, data, 0o664)
+	if err != nil {
+		return err
+	}
+	if system.IsSudo() {
+		return system.SetFilePermissions(path)
+	}
+	return nil
+}
+
+// mkdir creates a directory of the history, owned like its parent directory when running as root.
+func mkdir(path string) error {
+	_, err := os.Stat(path)
+	if err == nil {
+		return nil
+	}
+	err = os.Mkdir(path, 0o775)
+	if err != nil {
+		return err
+	}
+	if system.IsSudo() {
+		return system.SetFilePermissions(path)
+	}
+	return nil
+}
+
+// Snapshot saves the current content of the files before they are changed,
+// files that do not exist yet are recorded so a restore removes them.
+// The version is written to a temporary directory and renamed into place,
+// an interrupted snapshot never leaves an incomplete version.
+func Snapshot(dataDir string, paths ...string) (Version, error) {
+	ids, err := versionIDs(dataDir)
+	if err != nil {
+		return Version{}, err
+	}
+	version := Version{ID: 1, Time: time.Now(), Command: commandLine()}
+	if len(ids) > 0 {
+		version.ID = ids[len(ids)-1] + 1
+	}
+
+	if err := mkdir(Dir(dataDir)); err != nil {
+		return Version{}, err
+	}
+	tmp, err := os.MkdirTemp(Dir(dataDir), ".tmp-")
+	if err != nil {
+		return Version{}, err
+	}
+	defer os.RemoveAll(tmp)
+	for i, path := range paths {
+		data, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			version.Files = append(version.Files, File{Path: path})
+			continue
+		}
+		if err != nil {
+			return Version{}, err
+		}
+		if err := writeFile(savedPath(tmp, i, path), data); err != nil {
+			return Version{}, err
+		}
+		version.Files = append(version.Files, File{Path: path, Exists: true})
+	}
+
+	data, err := json.MarshalIndent(version, "", " ")
+	if err != nil {
+		return Version{}, err
+	}
+	if err := writeFile(filepath.Join(tmp, versionFilename), data); err != nil {
+		return Version{}, err
+	}
+	// MkdirTemp is only accessible by its owner
+	if err := os.Chmod(tmp, 0o775); err != nil {
+		return Version{}, err
+	}
+	if system.IsSudo() {
+		if err := system.SetFilePermissions(tmp); err != nil {
+			return Version{}, err
+		}
+	}
+	if err := os.Rename(tmp, versionDir(dataDir, version.ID)); err != nil {
+		return Version{}, err
+	}
+
+	return version, Prune(dataDir, MaxVersions)
+}
+
+// versionIDs returns the ids of the version directories, from the oldest to the latest,
+// including the incomplete versions left by an older NodeKit.
+func versionIDs(dataDir string) ([]int, error) {
+	entries, err := os.ReadDir(Dir(dataDir))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var ids []int
+	for _, entry := range entries {
+		id, err := strconv.Atoi(entry.Name())
+		if err != nil || !entry.IsDir() {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids, nil
+}
+
+// List returns the versions of a data directory, from the oldest to the latest.
+// Directories without a version.json are incomplete and skipped.
+func List(dataDir string) ([]Version, error) {
+	ids, err := versionIDs(dataDir)
+	if err != nil {
+		return nil, err
+	}
+	var versions []Version
+	for _, id := range ids {
+		version, err := Get(dataDir, id)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+	return versions, nil
+}
+
+// Get returns a version of a data directory.
+func Get(dataDir string, id int) (Version, error) {
+	var version Version
+	data, err := os.ReadFile(filepath.Join(versionDir(dataDir, id), versionFilename))
+	if errors.Is(err, os.ErrNotExist) {
+		return version, fmt.Errorf("version %d %w in %s", id, ErrNotFound, Dir(dataDir))
+	}
+	if err != nil {
+		return version, err
+	}
+	err = json.Unmarshal(data, &version)
+	return version, err
+}
+
+// Content returns the content of a file saved in a version, nil when it did not exist.
+func (v Version) Content(dataDir string, path string) ([]byte, error) {
+	for i, file := range v.Files {
+		if file.Path != path {
+			continue
+		}
+		if !file.Exists {
+			return nil, nil
+		}
+		return os.ReadFile(savedPath(versionDir(dataDir, v.ID), i, file.Path))
+	}
+	return nil, fmt.Errorf("%s is not part of version %d", path, v.ID)
+}
+
+// Restore writes back the files of a version and removes the files that did not exist.
+// The current files are saved first, so a restore can be undone like any other change.
+func Restore(dataDir string, id int) (Version, error) {
+	version, err := Get(dataDir, id)
+	if err != nil {
+		return version, err
+	}
+	// Read the version before the snapshot, which can prune it
+	paths := make([]string, 0, len(version.Files))
+	contents := make([][]byte, 0, len(version.Files))
+	for _, file := range version.Files {
+		data, err := version.Content(dataDir, file.Path)
+		if err != nil {
+			return version, err
+		}
+		paths = append(paths, file.Path)
+		contents = append(contents, data)
+	}
+	if _, err := Snapshot(dataDir, paths...); err != nil {
+		return version, err
+	}
+
+	for i, file := range version.Files {
+		if !file.Exists {
+			err := os.Remove(file.Path)
+			if err != nil && !errors.Is(err, os.ErrNotExist) {
+				return version, err
+			}
+			continue
+		}
+		if err := os.WriteFile(file.Path, contents[i], 0o664); err != nil {
+			return version, err
+		}
+		// Files of the data directory belong to the algod user
+		if system.IsSudo() && filepath.Dir(file.Path) == filepath.Clean(dataDir) {
+			if err := system.SetFilePermissions(file.Path); err != nil {
+				return version, err
+			}
+		}
+	}
+	return version, nil
+}
+
+// Prune removes the oldest versions of a data directory, keeping the latest ones,
+// and the incomplete versions.
+func Prune(dataDir string, keep int) error {
+	ids, err := versionIDs(dataDir)
+	if err != nil {
+		return err
+	}
+	var versions []int
+	for _, id := range ids {
+		_, err := Get(dataDir, id)
+		if errors.Is(err, ErrNotFound) {
+			if err := os.RemoveAll(versionDir(dataDir, id)); err != nil {
+				return err
+			}
+			continue
+		}
+		versions = append(versions, id)
+	}
+	for len(versions) > keep {
+		if err := os.RemoveAll(versionDir(dataDir, versions[0])); err != nil {
+			return err
+		}
+		versions = versions[1:]
+	}
+	return nil
+}
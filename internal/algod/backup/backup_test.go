@@ -0,0 +1,115 @@
+package backup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SnapshotRestore(t *testing.T) {
+	dataDir := t.TempDir()
+	configFile := filepath.Join(dataDir, "config.json")
+	overrideFile := filepath.Join(t.TempDir(), "override.conf")
+
+	versions, err := List(dataDir)
+	assert.Nil(t, err)
+	assert.Empty(t, versions)
+
+	// The override does not exist before the first change
+	assert.Nil(t, os.WriteFile(configFile, []byte(`{"Version": 34}`), 0o664))
+	version, err := Snapshot(dataDir, configFile, overrideFile)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, version.ID)
+	assert.Equal(t, []File{{Path: configFile, Exists: true}, {Path: overrideFile}}, version.Files)
+	assert.NotEmpty(t, version.Command)
+
+	assert.Nil(t, os.WriteFile(configFile, []byte(`{"Version": 34, "Archival": true}`), 0o664))
+	assert.Nil(t, os.WriteFile(overrideFile, []byte("[Service]"), 0o664))
+
+	content, err := version.Content(dataDir, configFile)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"Version": 34}`, string(content))
+	_, err = version.Content(dataDir, "logging.config")
+	assert.NotNil(t, err)
+
+	restored, err := Restore(dataDir, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, version.Files, restored.Files)
+	data, err := os.ReadFile(configFile)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"Version": 34}`, string(data))
+	_, err = os.Stat(overrideFile)
+	assert.True(t, os.IsNotExist(err))
+
+	// The rollback is saved as a version that undoes it
+	versions, err = List(dataDir)
+	assert.Nil(t, err)
+	assert.Len(t, versions, 2)
+	_, err = Restore(dataDir, versions[1].ID)
+	assert.Nil(t, err)
+	data, err = os.ReadFile(configFile)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"Version": 34, "Archival": true}`, string(data))
+	data, err = os.ReadFile(overrideFile)
+	assert.Nil(t, err)
+	assert.Equal(t, "[Service]", string(data))
+
+	_, err = Restore(dataDir, 42)
+	assert.ErrorContains(t, err, "version 42 not found")
+}
+
+func Test_Prune(t *testing.T) {
+	dataDir := t.TempDir()
+	configFile := filepath.Join(dataDir, "config.json")
+	for i := 0; i < 5; i++ {
+		_, err := Snapshot(dataDir, configFile)
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, Prune(dataDir, 2))
+	versions, err := List(dataDir)
+	assert.Nil(t, err)
+	assert.Len(t, versions, 2)
+	assert.Equal(t, 4, versions[0].ID)
+	assert.Equal(t, 5, versions[1].ID)
+
+	// Ids keep growing after a prune
+	version, err := Snapshot(dataDir, configFile)
+	assert.Nil(t, err)
+	assert.Equal(t, 6, version.ID)
+}
+
+func Test_CommandLine(t *testing.T) {
+	args := os.Args
+	defer func() { os.Args = args }()
+	os.Args = []string{"/usr/local/bin/nodekit", "configure", "algod", "--token", "secret", "--token=secret", "--set", "Archival=true"}
+	assert.Equal(t, "nodekit configure algod --token *** --token=*** --set Archival=true", commandLine())
+}
+
+func Test_Incomplete(t *testing.T) {
+	dataDir := t.TempDir()
+	configFile := filepath.Join(dataDir, "config.json")
+	_, err := Snapshot(dataDir, configFile)
+	assert.Nil(t, err)
+
+	// An interrupted snapshot of an older NodeKit left a version without version.json
+	assert.Nil(t, os.MkdirAll(versionDir(dataDir, 2), 0o775))
+	versions, err := List(dataDir)
+	assert.Nil(t, err)
+	assert.Len(t, versions, 1)
+	_, err = Get(dataDir, 2)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// The next snapshot skips its id and prunes it
+	version, err := Snapshot(dataDir, configFile)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, version.ID)
+	_, err = os.Stat(versionDir(dataDir, 2))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	// No temporary directory is left behind
+	entries, err := os.ReadDir(Dir(dataDir))
+	assert.Nil(t, err)
+	assert.Len(t, entries, 2)
+}
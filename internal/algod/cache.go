@@ -0,0 +1,166 @@
+package algod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod/participation"
+)
+
+// accountRefreshRounds is how many rounds an account fetched from the node is reused for.
+const accountRefreshRounds = 10
+
+// accountWorkers is the maximum number of accounts fetched from the node at once.
+const accountWorkers = 4
+
+// expiresTolerance is how far two estimates of a key expiration can drift apart
+// without being reported as a change, the estimate follows the clock on every round.
+const expiresTolerance = time.Minute
+
+// GetAccounts fetches the accounts of the addresses with at most workers requests at once.
+// Accounts that could not be fetched are missing from the result and their errors are joined.
+func GetAccounts(ctx context.Context, client api.ClientWithResponsesInterface, addresses []string, workers int) (map[string]api.Account, error) {
+	if workers < 1 {
+		workers = 1
+	}
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		errs []error
+	)
+	accounts := make(map[string]api.Account, len(addresses))
+	sem := make(chan struct{}, workers)
+	for _, address := range addresses {
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			acct, err := GetAccount(ctx, client, address)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", address, err))
+				return
+			}
+			accounts[address] = acct
+		}()
+	}
+	wg.Wait()
+	if ctx.Err() != nil {
+		errs = append(errs, ctx.Err())
+	}
+	return accounts, errors.Join(errs...)
+}
+
+// cachedAccount is an account fetched from the node with the round it was fetched at.
+type cachedAccount struct {
+	account api.Account
+	round   uint64
+}
+
+// accountCache keeps the accounts fetched by UpdateKeys between rounds.
+type accountCache map[string]cachedAccount
+
+// isStale reports whether the account must be fetched again at the round.
+// Accounts are reused for accountRefreshRounds, except when their registered key expired since they were fetched
+// or when a key on the node is not registered yet, a registration can land in any round.
+func (c accountCache) isStale(address string, keys participation.List, round uint64) bool {
+	entry, ok := c[address]
+	if !ok || round >= entry.round+accountRefreshRounds {
+		return true
+	}
+	registered := entry.account.Participation
+	if registered != nil && uint64(registered.VoteLastValid) >= entry.round && uint64(registered.VoteLastValid) < round {
+		return true
+	}
+	for _, key := range keys {
+		if key.Address != address || uint64(key.Key.VoteLastValid) < round {
+			continue
+		}
+		if registered == nil || !participation.IsActive(key, *registered) {
+			return true
+		}
+	}
+	return false
+}
+
+// keyIds returns the sorted ids of the keys of each address.
+func keyIds(keys participation.List) map[string]string {
+	ids := make(map[string][]string)
+	for _, key := range keys {
+		ids[key.Address] = append(ids[key.Address], key.Id)
+	}
+	joined := make(map[string]string, len(ids))
+	for address, list := range ids {
+		slices.Sort(list)
+		joined[address] = strings.Join(list, ",")
+	}
+	return joined
+}
+
+// invalidate drops the accounts with keys added to or removed from the node.
+func (c accountCache) invalidate(previous participation.List, keys participation.List) {
+	before, after := keyIds(previous), keyIds(keys)
+	for address, ids := range before {
+		if after[address] != ids {
+			delete(c, address)
+		}
+	}
+	for address, ids := range after {
+		if before[address] != ids {
+			delete(c, address)
+		}
+	}
+}
+
+// prune drops the accounts that are no longer tracked.
+func (c accountCache) prune(accounts map[string]Account) {
+	for address := range c {
+		if _, ok := accounts[address]; !ok {
+			delete(c, address)
+		}
+	}
+}
+
+// accountsEqual compares accounts, ignoring expiration estimates within expiresTolerance of each other.
+func accountsEqual(a map[string]Account, b map[string]Account) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for address, acct := range a {
+		other, ok := b[address]
+		if !ok {
+			return false
+		}
+		other.Expires = withinTolerance(acct.Expires, other.Expires)
+		other.ExpiresEarliest = withinTolerance(acct.ExpiresEarliest, other.ExpiresEarliest)
+		other.ExpiresLatest = withinTolerance(acct.ExpiresLatest, other.ExpiresLatest)
+		if !reflect.DeepEqual(acct, other) {
+			return false
+		}
+	}
+	return true
+}
+
+// withinTolerance returns the previous estimate when the next one is within expiresTolerance of it.
+func withinTolerance(previous *time.Time, next *time.Time) *time.Time {
+	if previous != nil && next != nil && previous.Sub(*next).Abs() < expiresTolerance {
+		return previous
+	}
+	return next
+}
@@ -0,0 +1,127 @@
+package algod
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/test"
+	"github.com/algorandfoundation/nodekit/internal/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+// countingClient counts the account requests and how many run at once.
+type countingClient struct {
+	api.ClientWithResponsesInterface
+	mu      sync.Mutex
+	calls   int
+	running int
+	peak    int
+}
+
+func (c *countingClient) AccountInformationWithResponse(ctx context.Context, address string, params *api.AccountInformationParams, reqEditors ...api.RequestEditorFn) (*api.AccountInformationResponse, error) {
+	c.mu.Lock()
+	c.calls++
+	c.running++
+	c.peak = max(c.peak, c.running)
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		c.running--
+		c.mu.Unlock()
+	}()
+	time.Sleep(10 * time.Millisecond)
+	if address == "FAIL" {
+		return nil, errors.New("account not found")
+	}
+	return c.ClientWithResponsesInterface.AccountInformationWithResponse(ctx, address, params, reqEditors...)
+}
+
+func Test_GetAccounts(t *testing.T) {
+	client := &countingClient{ClientWithResponsesInterface: test.GetClient(false)}
+	addresses := []string{"ABC", "EXPIRED", "A", "B", "C", "FAIL"}
+	accounts, err := GetAccounts(context.Background(), client, addresses, 2)
+	assert.ErrorContains(t, err, "FAIL: account not found")
+	assert.Len(t, accounts, 5)
+	assert.Equal(t, "Online", accounts["ABC"].Status)
+	assert.Equal(t, 6, client.calls)
+	assert.Equal(t, 2, client.peak)
+
+	// A cancelled context stops fetching
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	accounts, err = GetAccounts(ctx, client, addresses, 2)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, accounts)
+}
+
+func Test_AccountCache(t *testing.T) {
+	cache := accountCache{"ABC": {account: mock.ABCAccount, round: 100}}
+	keys := mock.Keys[:1]
+
+	assert.True(t, cache.isStale("EXPIRED", keys, 100))
+	assert.False(t, cache.isStale("ABC", keys, 109))
+	assert.True(t, cache.isStale("ABC", keys, 110))
+
+	// A key waiting for its registration is refreshed every round
+	assert.True(t, cache.isStale("ABC", mock.Keys, 101))
+
+	// The registered key expired since the account was fetched
+	cache["ABC"] = cachedAccount{account: mock.ABCAccount, round: 29995}
+	assert.True(t, cache.isStale("ABC", keys, 30001))
+
+	// Accounts with new keys are fetched again
+	cache.invalidate(keys, mock.Keys)
+	assert.NotContains(t, cache, "ABC")
+
+	cache["ABC"] = cachedAccount{account: mock.ABCAccount}
+	cache["OLD"] = cachedAccount{}
+	cache.prune(map[string]Account{"ABC": {Address: "ABC"}})
+	assert.Contains(t, cache, "ABC")
+	assert.NotContains(t, cache, "OLD")
+}
+
+func Test_AccountsEqual(t *testing.T) {
+	now := time.Now()
+	later := now.Add(30 * time.Second)
+	muchLater := now.Add(2 * time.Minute)
+	a := map[string]Account{"ABC": {Address: "ABC", Expires: &now}}
+	assert.True(t, accountsEqual(a, map[string]Account{"ABC": {Address: "ABC", Expires: &later}}))
+	assert.False(t, accountsEqual(a, map[string]Account{"ABC": {Address: "ABC", Expires: &muchLater}}))
+	assert.False(t, accountsEqual(a, map[string]Account{"ABC": {Address: "ABC", Expires: &now, Balance: 1}}))
+	assert.False(t, accountsEqual(a, map[string]Account{"EXPIRED": {Address: "EXPIRED", Expires: &now}}))
+	assert.False(t, accountsEqual(a, nil))
+}
+
+func Test_UpdateKeysCache(t *testing.T) {
+	client := &countingClient{ClientWithResponsesInterface: test.GetClient(false)}
+	// Every key expired, the accounts only need the periodic refresh
+	state := StateModel{
+		Status:  Status{LastRound: 40000, Client: client},
+		Metrics: Metrics{RoundTime: 2 * time.Second, Client: client},
+		Client:  client,
+	}
+	events, unsubscribe := state.Subscribe()
+	defer unsubscribe()
+
+	state.UpdateKeys(context.Background(), new(mock.Clock))
+	fetched := len(state.Accounts)
+	assert.Equal(t, fetched, client.calls)
+	assert.Equal(t, KeysChangedEvent, (<-events).Type)
+
+	// Nothing changed, the cached accounts are used
+	state.Status.LastRound++
+	state.UpdateKeys(context.Background(), new(mock.Clock))
+	assert.Equal(t, fetched, client.calls)
+	assert.Empty(t, events)
+
+	// The accounts are refreshed after the refresh interval
+	state.Status.LastRound += accountRefreshRounds
+	state.UpdateKeys(context.Background(), new(mock.Clock))
+	assert.Equal(t, 2*fetched, client.calls)
+	assert.Empty(t, events)
+	assert.Equal(t, "Offline", state.Accounts["ABC"].Status)
+}
@@ -7,9 +7,11 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"time"
 
 	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod/linux"
 	"github.com/algorandfoundation/nodekit/internal/algod/utils"
 	"github.com/charmbracelet/log"
 	"github.com/oapi-codegen/oapi-codegen/v2/pkg/securityprovider"
@@ -22,7 +24,7 @@ func GetDataDir(dataDir string) (string, error) {
 	// Priority:
 	// 1. Use provided `-d` directory
 	// 2. Use environment variable `ALGORAND_DATA`
-	// 3. Use default given by nodekit
+	// 3. Use default given by nodekit, the data directory of a user service on Linux
 	if dataDir == "" {
 		dataDir = os.Getenv("ALGORAND_DATA")
 
@@ -32,6 +34,13 @@ func GetDataDir(dataDir string) (string, error) {
 				dataDir = filepath.Join(os.Getenv("HOME"), ".algorand")
 			case "linux":
 				dataDir = "/var/lib/algorand"
+				// A user service has its own data directory, the system service is preferred when both exist
+				if linux.IsUserService() {
+					if userDataDir, err := linux.GetUserServiceDataDir(); err == nil {
+						log.Debugf("Using the data directory %s of the user service", userDataDir)
+						dataDir = userDataDir
+					}
+				}
 			default:
 				return "", errors.New(UnsupportedOSError)
 			}
@@ -52,11 +61,39 @@ func GetClient(dataDir string) (*api.ClientWithResponses, error) {
 		return nil, err
 	}
 
-	apiToken, err := securityprovider.NewSecurityProviderApiKey("header", "X-Algo-API-Token", config.Token)
+	return NewClient(config.Endpoint, config.Token)
+}
+
+// TokenEnv is the environment variable holding the admin token of a remote node.
+const TokenEnv = "ALGOD_TOKEN"
+
+// NewClient initializes and returns a new API client for the endpoint, authenticated with the admin token.
+func NewClient(endpoint string, token string) (*api.ClientWithResponses, error) {
+	apiToken, err := securityprovider.NewSecurityProviderApiKey("header", "X-Algo-API-Token", token)
 	if err != nil {
 		return nil, err
 	}
-	return api.NewClientWithResponses(config.Endpoint, api.WithRequestEditorFn(apiToken.Intercept))
+	return api.NewClientWithResponses(endpoint, api.WithRequestEditorFn(apiToken.Intercept))
+}
+
+// ResolveToken returns the admin token of a remote node.
+// The token takes priority over the content of the token file, followed by the TokenEnv environment variable.
+func ResolveToken(token string, tokenFile string) (string, error) {
+	if token != "" {
+		return token, nil
+	}
+	if tokenFile != "" {
+		data, err := os.ReadFile(tokenFile)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(data)), nil
+	}
+	token = os.Getenv(TokenEnv)
+	if token == "" {
+		return "", fmt.Errorf("an admin token is required for a remote node, use --token, --token-file or %s", TokenEnv)
+	}
+	return token, nil
 }
 
 func WaitForClient(ctx context.Context, dataDir string, interval time.Duration, timeout time.Duration) (*api.ClientWithResponses, error) {
@@ -0,0 +1,39 @@
+package algod
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ResolveToken(t *testing.T) {
+	t.Setenv(TokenEnv, "")
+	_, err := ResolveToken("", "")
+	assert.NotNil(t, err)
+
+	t.Setenv(TokenEnv, "env")
+	token, err := ResolveToken("", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "env", token)
+
+	path := filepath.Join(t.TempDir(), "algod.admin.token")
+	assert.Nil(t, os.WriteFile(path, []byte("file\n"), 0600))
+	token, err = ResolveToken("", path)
+	assert.Nil(t, err)
+	assert.Equal(t, "file", token)
+
+	token, err = ResolveToken("flag", path)
+	assert.Nil(t, err)
+	assert.Equal(t, "flag", token)
+
+	_, err = ResolveToken("", filepath.Join(t.TempDir(), "missing"))
+	assert.NotNil(t, err)
+}
+
+func Test_NewClient(t *testing.T) {
+	client, err := NewClient("http://localhost:8080", "token")
+	assert.Nil(t, err)
+	assert.NotNil(t, client)
+}
@@ -1,24 +1,188 @@
 package config
 
-// Config represents the configuration settings for algod, including enabling P2PHybrid
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Config represents the configuration settings for algod that NodeKit knows how to validate.
+// Every field is optional, unset fields keep the value of the config.json or the algod default.
 type Config struct {
+	// Version of the config.json format
+	Version *int `json:"Version,omitempty"`
+
+	// Archival nodes keep every block instead of the recent ones
+	Archival *bool `json:"Archival,omitempty"`
+	// EnableFollowMode runs a non-participating node that lets a client control the ledger rounds
+	EnableFollowMode *bool `json:"EnableFollowMode,omitempty"`
+
+	// CatchupParallelBlocks is the number of blocks fetched at once while catching up
+	CatchupParallelBlocks *uint64 `json:"CatchupParallelBlocks,omitempty"`
+	// CatchupBlockDownloadRetryAttempts is how many times a block is requested before giving up
+	CatchupBlockDownloadRetryAttempts *int `json:"CatchupBlockDownloadRetryAttempts,omitempty"`
+	// CatchupFailurePeerRefreshRate is how many failed block requests refresh the peer list
+	CatchupFailurePeerRefreshRate *int `json:"CatchupFailurePeerRefreshRate,omitempty"`
+	// CatchpointTracking controls the catchpoints made by the node, from -1 (none) to 2 (always)
+	CatchpointTracking *int64 `json:"CatchpointTracking,omitempty"`
+	// CatchpointInterval is the number of rounds between catchpoints
+	CatchpointInterval *uint64 `json:"CatchpointInterval,omitempty"`
+
+	// DNSBootstrapID is the DNS name used to find the relays of the network
+	DNSBootstrapID *string `json:"DNSBootstrapID,omitempty"`
+	// GossipFanout is the number of relays the node connects to
+	GossipFanout *int `json:"GossipFanout,omitempty"`
+	// NetAddress is the address relays listen on for gossip, empty for non-relays
+	NetAddress *string `json:"NetAddress,omitempty"`
+	// PublicAddress is the address of a relay advertised to its peers
+	PublicAddress *string `json:"PublicAddress,omitempty"`
+	// FallbackDNSResolverAddress is the DNS resolver used when the system resolver fails
+	FallbackDNSResolverAddress *string `json:"FallbackDNSResolverAddress,omitempty"`
+	// MaxConnectionsPerIP limits the incoming connections of a single address
+	MaxConnectionsPerIP *int `json:"MaxConnectionsPerIP,omitempty"`
+	// IncomingConnectionsLimit limits the incoming gossip connections
+	IncomingConnectionsLimit *int `json:"IncomingConnectionsLimit,omitempty"`
+	// EnableLedgerService serves the ledger to the nodes running a Fast-Catchup
+	EnableLedgerService *bool `json:"EnableLedgerService,omitempty"`
+	// EnableBlockService serves blocks to the nodes that are catching up
+	EnableBlockService *bool `json:"EnableBlockService,omitempty"`
+
+	// EndpointAddress is the address of the REST API
+	EndpointAddress *string `json:"EndpointAddress,omitempty"`
+	// EnableDeveloperAPI enables the endpoints used for development, like compiling TEAL
+	EnableDeveloperAPI *bool `json:"EnableDeveloperAPI,omitempty"`
+	// EnableExperimentalAPI enables the endpoints that are not stable yet
+	EnableExperimentalAPI *bool `json:"EnableExperimentalAPI,omitempty"`
+	// RestReadTimeoutSeconds is the timeout to read a REST request
+	RestReadTimeoutSeconds *int `json:"RestReadTimeoutSeconds,omitempty"`
+	// RestWriteTimeoutSeconds is the timeout to write a REST response
+	RestWriteTimeoutSeconds *int `json:"RestWriteTimeoutSeconds,omitempty"`
+	// EnableMetricReporting serves the Prometheus metrics of the node
+	EnableMetricReporting *bool `json:"EnableMetricReporting,omitempty"`
+	// NodeExporterListenAddress is the address of the Prometheus metrics
+	NodeExporterListenAddress *string `json:"NodeExporterListenAddress,omitempty"`
+
+	// TLSCertFile and TLSKeyFile serve the REST API over TLS, both must be set
+	TLSCertFile *string `json:"TLSCertFile,omitempty"`
+	TLSKeyFile  *string `json:"TLSKeyFile,omitempty"`
+
+	// BaseLoggerDebugLevel is the log level, from 0 (panic) to 5 (debug)
+	BaseLoggerDebugLevel *uint32 `json:"BaseLoggerDebugLevel,omitempty"`
+	// LogSizeLimit is the size in bytes of node.log before it is archived
+	LogSizeLimit *uint64 `json:"LogSizeLimit,omitempty"`
+	// LogArchiveMaxAge is how long archived logs are kept, as a duration like 24h
+	LogArchiveMaxAge *string `json:"LogArchiveMaxAge,omitempty"`
+	// LogArchiveName is the name of the archived logs
+	LogArchiveName *string `json:"LogArchiveName,omitempty"`
+	// LogArchiveDir is the directory of the archived logs
+	LogArchiveDir *string `json:"LogArchiveDir,omitempty"`
+	// LogFileDir is the directory of node.log
+	LogFileDir *string `json:"LogFileDir,omitempty"`
+
+	// EnableP2P uses the P2P network instead of the relays
+	EnableP2P *bool `json:"EnableP2P,omitempty"`
+	// EnableP2PHybridMode connects to both the relays and the P2P network
 	EnableP2PHybridMode *bool `json:"EnableP2PHybridMode,omitempty"`
+	// P2PHybridNetAddress is the address the P2P network listens on in hybrid mode
+	P2PHybridNetAddress *string `json:"P2PHybridNetAddress,omitempty"`
+	// EnableDHTProviders advertises the node as a provider of the P2P services
+	EnableDHTProviders *bool `json:"EnableDHTProviders,omitempty"`
+	// P2PPersistPeerID keeps the P2P identity of the node between restarts
+	P2PPersistPeerID *bool `json:"P2PPersistPeerID,omitempty"`
+	// P2PPrivateKeyLocation is the path of the P2P identity
+	P2PPrivateKeyLocation *string `json:"P2PPrivateKeyLocation,omitempty"`
+
+	// ProposalAssemblyTime is how long a proposer assembles its block in nanoseconds,
+	// a longer time includes more transactions and fees at the risk of a late proposal
+	ProposalAssemblyTime *int64 `json:"ProposalAssemblyTime,omitempty"`
+	// TxPoolSize is the number of pending transactions the node keeps for its proposals
+	TxPoolSize *int `json:"TxPoolSize,omitempty"`
+	// ParticipationKeysRefreshInterval is how often new participation keys are loaded in nanoseconds
+	ParticipationKeysRefreshInterval *int64 `json:"ParticipationKeysRefreshInterval,omitempty"`
 }
 
 // IsEqual compares two Config objects and returns true if all their fields have the same values, otherwise false.
 func (c Config) IsEqual(conf Config) bool {
-	return c.EnableP2PHybridMode == conf.EnableP2PHybridMode
+	return reflect.DeepEqual(c, conf)
 }
 
 // MergeAlgodConfigs merges two Config objects, with non-zero and non-default fields in 'b' overriding those in 'a'.
 func MergeAlgodConfigs(a Config, b Config) Config {
 	merged := a
 
-	if b.EnableP2PHybridMode != nil {
-		if a.EnableP2PHybridMode == nil || *b.EnableP2PHybridMode != *a.EnableP2PHybridMode {
-			merged.EnableP2PHybridMode = b.EnableP2PHybridMode
+	// Every field is a pointer, set fields of b replace the fields of a
+	mergedValue := reflect.ValueOf(&merged).Elem()
+	bValue := reflect.ValueOf(b)
+	for i := 0; i < bValue.NumField(); i++ {
+		if !bValue.Field(i).IsNil() {
+			mergedValue.Field(i).Set(bValue.Field(i))
 		}
 	}
 
 	return merged
 }
+
+// Keys returns the config.json keys of the Config fields.
+func Keys() []string {
+	t := reflect.TypeOf(Config{})
+	keys := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		keys = append(keys, jsonKey(t.Field(i)))
+	}
+	return keys
+}
+
+// jsonKey returns the config.json key of a field.
+func jsonKey(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+// Values returns the set fields of the Config by config.json key.
+func (c Config) Values() map[string]any {
+	values := make(map[string]any)
+	v := reflect.ValueOf(c)
+	for i := 0; i < v.NumField(); i++ {
+		if !v.Field(i).IsNil() {
+			values[jsonKey(v.Type().Field(i))] = v.Field(i).Elem().Interface()
+		}
+	}
+	return values
+}
+
+// Parse reads a config.json, it returns an error for values of the wrong type
+// and a warning for each key that algod does not know, like misspelled settings.
+func Parse(data []byte) (Config, []string, error) {
+	var config Config
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return config, nil, err
+	}
+
+	var typeError *json.UnmarshalTypeError
+	if err := json.Unmarshal(data, &config); errors.As(err, &typeError) {
+		return config, nil, fmt.Errorf("%s: expected a value of type %s, got %s", typeError.Field, typeError.Type, typeError.Value)
+	} else if err != nil {
+		return config, nil, err
+	}
+
+	var warnings []string
+	for key := range raw {
+		if IsKnownKey(key) {
+			continue
+		}
+		warning := fmt.Sprintf("%s is not a known algod setting", key)
+		if suggestion := suggestKey(key); suggestion != "" {
+			warning += fmt.Sprintf(", did you mean %s?", suggestion)
+		}
+		warnings = append(warnings, warning)
+	}
+	sort.Strings(warnings)
+	return config, warnings, nil
+}
@@ -0,0 +1,83 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Parse(t *testing.T) {
+	config, warnings, err := Parse([]byte(`{
+		"Version": 34,
+		"Archival": true,
+		"CatchupParallelBlocks": 32,
+		"EndpointAddress": "127.0.0.1:8080",
+		"EnableRuntimeMetrics": true,
+		"GosipFanout": 4,
+		"enableP2P": false,
+		"EnableP2PHybrid": true
+	}`))
+	assert.Nil(t, err)
+	assert.Equal(t, Config{
+		Version:               ptr(34),
+		Archival:              ptr(true),
+		CatchupParallelBlocks: ptr(uint64(32)),
+		EndpointAddress:       ptr("127.0.0.1:8080"),
+		EnableP2P:             ptr(false),
+	}, config)
+	// Settings NodeKit does not validate are known, misspelled settings are not
+	assert.Equal(t, []string{
+		"EnableP2PHybrid is not a known algod setting",
+		"GosipFanout is not a known algod setting, did you mean GossipFanout?",
+	}, warnings)
+
+	_, _, err = Parse([]byte(`{"Archival": "yes"}`))
+	assert.ErrorContains(t, err, "Archival: expected a value of type bool, got string")
+	_, _, err = Parse([]byte(`{"CatchupParallelBlocks": -1}`))
+	assert.ErrorContains(t, err, "CatchupParallelBlocks")
+	_, _, err = Parse([]byte(`{`))
+	assert.NotNil(t, err)
+}
+
+func Test_Validate(t *testing.T) {
+	assert.Nil(t, Config{}.Validate())
+	assert.Nil(t, Config{
+		GossipFanout:         ptr(4),
+		NetAddress:           ptr(""),
+		EndpointAddress:      ptr(":8080"),
+		BaseLoggerDebugLevel: ptr(uint32(4)),
+		LogArchiveMaxAge:     ptr("72h"),
+		TLSCertFile:          ptr("cert.pem"),
+		TLSKeyFile:           ptr("key.pem"),
+	}.Validate())
+
+	err := Config{
+		CatchupParallelBlocks: ptr(uint64(0)),
+		GossipFanout:          ptr(100),
+		EndpointAddress:       ptr("localhost"),
+		NetAddress:            ptr(":99999"),
+		BaseLoggerDebugLevel:  ptr(uint32(6)),
+		DNSBootstrapID:        ptr(""),
+		LogArchiveMaxAge:      ptr("3 days"),
+		TLSCertFile:           ptr("cert.pem"),
+	}.Validate()
+	assert.ErrorContains(t, err, "CatchupParallelBlocks: 0 must be at least 1")
+	assert.ErrorContains(t, err, "GossipFanout: 100 is not between 1 and 64")
+	assert.ErrorContains(t, err, `EndpointAddress: "localhost" is not a host:port address`)
+	assert.ErrorContains(t, err, `NetAddress: "99999" is not a valid port`)
+	assert.ErrorContains(t, err, "BaseLoggerDebugLevel: 6 is not between 0 and 5")
+	assert.ErrorContains(t, err, "DNSBootstrapID: must not be empty")
+	assert.ErrorContains(t, err, `LogArchiveMaxAge: "3 days" is not a duration`)
+	assert.ErrorContains(t, err, "TLSCertFile and TLSKeyFile must be set together")
+}
+
+func Test_MergeAlgodConfigs(t *testing.T) {
+	current := Config{Archival: ptr(true), GossipFanout: ptr(4), EnableP2PHybridMode: ptr(false)}
+	merged := MergeAlgodConfigs(current, Config{GossipFanout: ptr(8), EnableP2PHybridMode: ptr(true)})
+	assert.Equal(t, Config{Archival: ptr(true), GossipFanout: ptr(8), EnableP2PHybridMode: ptr(true)}, merged)
+	assert.False(t, current.IsEqual(merged))
+	// Equal values behind different pointers are equal
+	assert.True(t, merged.IsEqual(MergeAlgodConfigs(merged, Config{GossipFanout: ptr(8)})))
+	assert.Equal(t, map[string]any{"Archival": true, "GossipFanout": 8, "EnableP2PHybridMode": true}, merged.Values())
+	assert.Contains(t, Keys(), "EnableP2PHybridMode")
+}
@@ -0,0 +1,191 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// field returns the index and the config.json key of the field of a key, matched regardless of its case.
+func field(key string) (int, string, bool) {
+	t := reflect.TypeOf(Config{})
+	for i := 0; i < t.NumField(); i++ {
+		if name := jsonKey(t.Field(i)); strings.EqualFold(name, key) {
+			return i, name, true
+		}
+	}
+	return 0, "", false
+}
+
+// unknownKeyError describes a key that is not part of the Config, suggesting the closest key.
+func unknownKeyError(key string) error {
+	if IsKnownKey(key) {
+		return fmt.Errorf("%s is not supported by NodeKit, edit the config.json to change it", key)
+	}
+	if suggestion := suggestKey(key); suggestion != "" {
+		return fmt.Errorf("%s is not a known algod setting, did you mean %s?", key, suggestion)
+	}
+	return fmt.Errorf("%s is not a known algod setting", key)
+}
+
+// Set parses the value of a key into its field, the value must match the type of the setting.
+func (c *Config) Set(key string, value string) error {
+	i, name, ok := field(key)
+	if !ok {
+		return unknownKeyError(key)
+	}
+	target := reflect.ValueOf(c).Elem().Field(i)
+	parsed := reflect.New(target.Type().Elem())
+	var err error
+	switch kind := parsed.Elem().Kind(); kind {
+	case reflect.Bool:
+		var v bool
+		v, err = strconv.ParseBool(value)
+		parsed.Elem().SetBool(v)
+	case reflect.Int, reflect.Int64:
+		var v int64
+		v, err = strconv.ParseInt(value, 10, parsed.Elem().Type().Bits())
+		parsed.Elem().SetInt(v)
+	case reflect.Uint32, reflect.Uint64:
+		var v uint64
+		v, err = strconv.ParseUint(value, 10, parsed.Elem().Type().Bits())
+		parsed.Elem().SetUint(v)
+	case reflect.String:
+		parsed.Elem().SetString(value)
+	default:
+		return fmt.Errorf("%s: unsupported type %s", name, kind)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %q is not a valid %s", name, value, parsed.Elem().Type())
+	}
+	target.Set(parsed)
+	return nil
+}
+
+// Unset clears the field of a key, it returns false when the key is not part of the Config.
+func (c *Config) Unset(key string) bool {
+	i, _, ok := field(key)
+	if !ok {
+		return false
+	}
+	value := reflect.ValueOf(c).Elem().Field(i)
+	value.Set(reflect.Zero(value.Type()))
+	return true
+}
+
+// decodeFile returns the raw values of a config.json by key, data can be empty for a missing file.
+func decodeFile(data []byte) (map[string]json.RawMessage, error) {
+	raw := make(map[string]json.RawMessage)
+	if len(bytes.TrimSpace(data)) > 0 {
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, err
+		}
+	}
+	return raw, nil
+}
+
+// Apply writes the set fields of the Config into a config.json and removes the unset keys.
+// The other keys of the file are kept, data can be empty for a new file.
+func Apply(data []byte, c Config, unset []string) ([]byte, error) {
+	current, err := decodeFile(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// We only want user-defined values (non-nil), so omitempty removes
+	// everything else when unmarshaling into changes.
+	encoded, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	var changes map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &changes); err != nil {
+		return nil, err
+	}
+
+	// algod reads keys regardless of their case, replace the differently cased keys
+	remove := func(key string) {
+		for existing := range current {
+			if strings.EqualFold(existing, key) {
+				delete(current, existing)
+			}
+		}
+	}
+	for _, key := range unset {
+		remove(key)
+	}
+	for key, value := range changes {
+		remove(key)
+		current[key] = value
+	}
+	return json.MarshalIndent(current, "", "\t")
+}
+
+// Change is a key of a config.json that differs between two versions,
+// Before or After is empty when the key is missing from that version.
+type Change struct {
+	Key    string
+	Before string
+	After  string
+}
+
+// Diff returns the keys that differ between two versions of a config.json, sorted by key.
+func Diff(before []byte, after []byte) ([]Change, error) {
+	decode := func(data []byte) (map[string]string, error) {
+		raw, err := decodeFile(data)
+		if err != nil {
+			return nil, err
+		}
+		values := make(map[string]string, len(raw))
+		for key, value := range raw {
+			var compact bytes.Buffer
+			if err := json.Compact(&compact, value); err != nil {
+				return nil, err
+			}
+			values[key] = compact.String()
+		}
+		return values, nil
+	}
+	a, err := decode(before)
+	if err != nil {
+		return nil, err
+	}
+	b, err := decode(after)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []Change
+	for key, value := range a {
+		if b[key] != value {
+			changes = append(changes, Change{Key: key, Before: value, After: b[key]})
+		}
+	}
+	for key, value := range b {
+		if _, ok := a[key]; !ok {
+			changes = append(changes, Change{Key: key, After: value})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Key < changes[j].Key
+	})
+	return changes, nil
+}
+
+// Get returns the raw value of a key in a config.json, matched regardless of its case.
+func Get(data []byte, key string) (string, bool, error) {
+	raw, err := decodeFile(data)
+	if err != nil {
+		return "", false, err
+	}
+	for existing, value := range raw {
+		if strings.EqualFold(existing, key) {
+			return string(value), true, nil
+		}
+	}
+	return "", false, nil
+}
@@ -0,0 +1,77 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Set(t *testing.T) {
+	c := Config{}
+	assert.Nil(t, c.Set("archival", "true"))
+	assert.Nil(t, c.Set("GossipFanout", "8"))
+	assert.Nil(t, c.Set("CatchupParallelBlocks", "32"))
+	assert.Nil(t, c.Set("BaseLoggerDebugLevel", "4"))
+	assert.Nil(t, c.Set("EndpointAddress", "0.0.0.0:8080"))
+	assert.Equal(t, Config{
+		Archival:              ptr(true),
+		GossipFanout:          ptr(8),
+		CatchupParallelBlocks: ptr(uint64(32)),
+		BaseLoggerDebugLevel:  ptr(uint32(4)),
+		EndpointAddress:       ptr("0.0.0.0:8080"),
+	}, c)
+
+	assert.EqualError(t, c.Set("Archival", "yes"), `Archival: "yes" is not a valid bool`)
+	assert.EqualError(t, c.Set("CatchupParallelBlocks", "-1"), `CatchupParallelBlocks: "-1" is not a valid uint64`)
+	assert.EqualError(t, c.Set("GosipFanout", "4"), "GosipFanout is not a known algod setting, did you mean GossipFanout?")
+	assert.EqualError(t, c.Set("EnableProfiler", "true"), "EnableProfiler is not supported by NodeKit, edit the config.json to change it")
+
+	assert.True(t, c.Unset("gossipfanout"))
+	assert.Nil(t, c.GossipFanout)
+	assert.False(t, c.Unset("EnableProfiler"))
+}
+
+func Test_Apply(t *testing.T) {
+	current := []byte(`{"Version": 34, "archival": false, "GosipFanout": 2, "EnableProfiler": true}`)
+	data, err := Apply(current, Config{Archival: ptr(true), GossipFanout: ptr(8)}, []string{"GosipFanout"})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\n\t\"Archival\": true,\n\t\"EnableProfiler\": true,\n\t\"GossipFanout\": 8,\n\t\"Version\": 34\n}", string(data))
+
+	// A missing file is created from the changes
+	data, err = Apply(nil, Config{EnableP2PHybridMode: ptr(true)}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\n\t\"EnableP2PHybridMode\": true\n}", string(data))
+
+	_, err = Apply([]byte(`{`), Config{}, nil)
+	assert.NotNil(t, err)
+}
+
+func Test_Diff(t *testing.T) {
+	changes, err := Diff(
+		[]byte(`{"Version": 34, "archival": false, "GossipFanout": 4}`),
+		[]byte(`{"Version": 34, "Archival": true, "GossipFanout": 8, "EnableP2P": true}`),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, []Change{
+		{Key: "Archival", After: "true"},
+		{Key: "EnableP2P", After: "true"},
+		{Key: "GossipFanout", Before: "4", After: "8"},
+		{Key: "archival", Before: "false"},
+	}, changes)
+
+	changes, err = Diff([]byte(`{"Version": 34}`), []byte("{\n\t\"Version\": 34\n}"))
+	assert.Nil(t, err)
+	assert.Empty(t, changes)
+}
+
+func Test_Get(t *testing.T) {
+	data := []byte(`{"Version": 34, "DNSBootstrapID": "<network>.algorand.network"}`)
+	value, ok, err := Get(data, "dnsbootstrapid")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, `"<network>.algorand.network"`, value)
+
+	_, ok, err = Get(data, "Archival")
+	assert.Nil(t, err)
+	assert.False(t, ok)
+}
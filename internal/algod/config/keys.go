@@ -0,0 +1,82 @@
+package config
+
+import (
+	"slices"
+	"strings"
+)
+
+// otherKeys are algod settings that are accepted in a config.json without being validated by NodeKit.
+var otherKeys = []string{
+	"AccountUpdatesStatsInterval", "AccountsRebuildSynchronousMode", "AgreementIncomingBundlesQueueLength",
+	"AgreementIncomingProposalsQueueLength", "AgreementIncomingVotesQueueLength", "AnnounceParticipationKey",
+	"BlockDBDir", "BlockServiceCustomFallbackEndpoints", "BlockServiceMemCap", "BroadcastConnectionsLimit",
+	"CadaverDirectory", "CadaverSizeTarget", "CatchpointDir", "CatchpointFileHistoryLength",
+	"CatchupBlockValidateMode", "CatchupGossipBlockFetchTimeoutSec", "CatchupHTTPBlockFetchTimeoutSec",
+	"CatchupLedgerDownloadRetryAttempts", "ColdDataDir", "ConnectionsRateLimitingCount",
+	"ConnectionsRateLimitingWindowSeconds", "CrashDBDir", "DNSSecurityFlags", "DeadlockDetection",
+	"DeadlockDetectionThreshold", "DisableAPIAuth", "DisableLedgerLRUCache", "DisableLocalhostConnectionRateLimit",
+	"DisableNetworking", "DisableOutgoingConnectionThrottling", "EnableAccountUpdatesStats",
+	"EnableAgreementReporting", "EnableAgreementTimeMetrics", "EnableAssembleStats",
+	"EnableGossipBlockService", "EnableGossipService", "EnableIncomingMessageFilter",
+	"EnableNetDevMetrics", "EnableOutgoingNetworkMessageFiltering", "EnablePingHandler",
+	"EnablePrivateNetworkAccessHeader", "EnableProcessBlockStats", "EnableProfiler", "EnableRequestLogger",
+	"EnableRuntimeMetrics", "EnableTopAccountsReporting", "EnableTxBacklogAppRateLimiting",
+	"EnableTxBacklogRateLimiting", "EnableTxnEvalTracer", "EnableUsageLog", "EnableVerbosedTransactionSyncLogging",
+	"EnableVoteCompression", "ForceFetchTransactions", "ForceRelayMessages", "GoMemLimit",
+	"HeartbeatUpdateInterval", "HotDataDir", "IncomingMessageFilterBucketCount", "IncomingMessageFilterBucketSize",
+	"IsIndexerActive", "LedgerSynchronousMode", "MaxAPIBoxPerApplication", "MaxAPIResourcesPerAccount",
+	"MaxAcctLookback", "MaxBlockHistoryLookback", "MaxCatchpointDownloadDuration",
+	"MinCatchpointFileDownloadBytesPerSecond", "NetworkMessageTraceServer", "NetworkProtocolVersion",
+	"NodeExporterPath", "OptimizeAccountsDatabaseOnStartup", "OutgoingMessageFilterBucketCount",
+	"OutgoingMessageFilterBucketSize", "P2PIncomingConnectionsLimit", "PeerConnectionsUpdateInterval",
+	"PeerPingPeriodSeconds", "PriorityPeers", "ReconnectTime", "ReservedFDs", "RestConnectionsHardLimit",
+	"RestConnectionsSoftLimit", "RunHosted", "StateproofDir", "StorageEngine", "SuggestedFeeBlockHistory",
+	"SuggestedFeeSlidingWindowSize", "TelemetryToLog", "TrackerDBDir", "TransactionSyncDataExchangeRate",
+	"TransactionSyncSignificantMessageThreshold", "TxBacklogAppRateLimitingCountERLDrops",
+	"TxBacklogAppTxPerSecondRate", "TxBacklogAppTxRateLimiterMaxSize", "TxBacklogRateLimitingCongestionPct",
+	"TxBacklogReservedCapacityPerPeer", "TxBacklogServiceRateWindowSeconds", "TxBacklogSize",
+	"TxIncomingFilterMaxSize", "TxIncomingFilteringFlags", "TxPoolExponentialIncreaseFactor",
+	"TxSyncIntervalSeconds", "TxSyncServeResponseSize", "TxSyncTimeoutSeconds", "UseXForwardedForAddressField",
+	"VerifiedTranscationsCacheSize",
+}
+
+// IsKnownKey reports whether algod knows the config.json key,
+// keys are matched regardless of their case like algod does when it reads the file.
+func IsKnownKey(key string) bool {
+	match := func(known string) bool {
+		return strings.EqualFold(known, key)
+	}
+	return slices.ContainsFunc(Keys(), match) || slices.ContainsFunc(otherKeys, match)
+}
+
+// suggestKey returns the known key closest to a misspelled key, or an empty string when none is close.
+func suggestKey(key string) string {
+	best, bestDistance := "", 3
+	for _, known := range append(Keys(), otherKeys...) {
+		if distance := levenshtein(strings.ToLower(known), strings.ToLower(key)); distance < bestDistance {
+			best, bestDistance = known, distance
+		}
+	}
+	return best
+}
+
+// levenshtein returns the number of single character edits between two strings.
+func levenshtein(a string, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
@@ -0,0 +1,157 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Profile is a set of config.json settings for a role of the node.
+type Profile struct {
+	Name        string
+	Description string
+	Config      Config
+}
+
+// AppliedProfile records the profile written to the config.json of a data directory.
+type AppliedProfile struct {
+	Name      string    `json:"name"`
+	AppliedAt time.Time `json:"appliedAt"`
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// Profiles are the built-in roles of a node. Every profile sets the same keys,
+// so switching between them never leaves a setting of the previous role behind.
+var Profiles = []Profile{
+	{
+		Name:        "participation",
+		Description: "Participates in consensus, serves nothing to other nodes",
+		Config: Config{
+			Archival:              ptr(false),
+			NetAddress:            ptr(""),
+			EnableLedgerService:   ptr(false),
+			EnableBlockService:    ptr(false),
+			EnableDeveloperAPI:    ptr(false),
+			EnableExperimentalAPI: ptr(false),
+			EnableP2PHybridMode:   ptr(true),
+			P2PHybridNetAddress:   ptr(""),
+			EnableDHTProviders:    ptr(false),
+			P2PPersistPeerID:      ptr(false),
+		},
+	},
+	{
+		Name:        "api",
+		Description: "Non-archival node serving the developer and experimental REST APIs",
+		Config: Config{
+			Archival:              ptr(false),
+			NetAddress:            ptr(""),
+			EnableLedgerService:   ptr(false),
+			EnableBlockService:    ptr(false),
+			EnableDeveloperAPI:    ptr(true),
+			EnableExperimentalAPI: ptr(true),
+			EnableP2PHybridMode:   ptr(true),
+			P2PHybridNetAddress:   ptr(""),
+			EnableDHTProviders:    ptr(false),
+			P2PPersistPeerID:      ptr(false),
+		},
+	},
+	{
+		Name:        "archival",
+		Description: "Keeps every block and serves the REST APIs, cannot use Fast-Catchup",
+		Config: Config{
+			Archival:              ptr(true),
+			NetAddress:            ptr(""),
+			EnableLedgerService:   ptr(false),
+			EnableBlockService:    ptr(false),
+			EnableDeveloperAPI:    ptr(true),
+			EnableExperimentalAPI: ptr(true),
+			EnableP2PHybridMode:   ptr(true),
+			P2PHybridNetAddress:   ptr(""),
+			EnableDHTProviders:    ptr(false),
+			P2PPersistPeerID:      ptr(false),
+		},
+	},
+	{
+		Name:        "relay",
+		Description: "Archival relay accepting gossip connections on port 4160",
+		Config: Config{
+			Archival:              ptr(true),
+			NetAddress:            ptr(":4160"),
+			EnableLedgerService:   ptr(true),
+			EnableBlockService:    ptr(true),
+			EnableDeveloperAPI:    ptr(false),
+			EnableExperimentalAPI: ptr(false),
+			EnableP2PHybridMode:   ptr(false),
+			P2PHybridNetAddress:   ptr(""),
+			EnableDHTProviders:    ptr(false),
+			P2PPersistPeerID:      ptr(false),
+		},
+	},
+	{
+		Name:        "hybrid-relay",
+		Description: "Archival relay accepting gossip on port 4160 and P2P connections on port 4190",
+		Config: Config{
+			Archival:              ptr(true),
+			NetAddress:            ptr(":4160"),
+			EnableLedgerService:   ptr(true),
+			EnableBlockService:    ptr(true),
+			EnableDeveloperAPI:    ptr(false),
+			EnableExperimentalAPI: ptr(false),
+			EnableP2PHybridMode:   ptr(true),
+			P2PHybridNetAddress:   ptr(":4190"),
+			EnableDHTProviders:    ptr(true),
+			P2PPersistPeerID:      ptr(true),
+		},
+	},
+}
+
+// ProfileNames returns the names of the built-in profiles.
+func ProfileNames() []string {
+	names := make([]string, 0, len(Profiles))
+	for _, profile := range Profiles {
+		names = append(names, profile.Name)
+	}
+	return names
+}
+
+// GetProfile returns the built-in profile of a name.
+func GetProfile(name string) (Profile, error) {
+	for _, profile := range Profiles {
+		if strings.EqualFold(profile.Name, name) {
+			return profile, nil
+		}
+	}
+	return Profile{}, fmt.Errorf("unknown profile %s, expected one of %s", name, strings.Join(ProfileNames(), ", "))
+}
+
+// Matches reports whether every setting of the profile has its value in the Config.
+func (p Profile) Matches(c Config) bool {
+	want := reflect.ValueOf(p.Config)
+	got := reflect.ValueOf(c)
+	for i := 0; i < want.NumField(); i++ {
+		if want.Field(i).IsNil() {
+			continue
+		}
+		if got.Field(i).IsNil() || !reflect.DeepEqual(want.Field(i).Elem().Interface(), got.Field(i).Elem().Interface()) {
+			return false
+		}
+	}
+	return true
+}
+
+// DescribeProfile returns the name of an applied profile, marked as modified when
+// the Config no longer has its settings. It is empty when no profile was applied.
+func DescribeProfile(applied *AppliedProfile, c Config) string {
+	if applied == nil || applied.Name == "" {
+		return ""
+	}
+	profile, err := GetProfile(applied.Name)
+	if err != nil || !profile.Matches(c) {
+		return applied.Name + " (modified)"
+	}
+	return profile.Name
+}
@@ -0,0 +1,47 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Profiles(t *testing.T) {
+	keys := func(c Config) []string {
+		var set []string
+		value := reflect.ValueOf(c)
+		for i := 0; i < value.NumField(); i++ {
+			if !value.Field(i).IsNil() {
+				set = append(set, jsonKey(value.Type().Field(i)))
+			}
+		}
+		return set
+	}
+	for _, profile := range Profiles {
+		// Switching profiles must replace every setting of the previous one
+		assert.Equal(t, keys(Profiles[0].Config), keys(profile.Config), profile.Name)
+		assert.Nil(t, profile.Config.Validate(), profile.Name)
+	}
+}
+
+func Test_GetProfile(t *testing.T) {
+	profile, err := GetProfile("Relay")
+	assert.Nil(t, err)
+	assert.Equal(t, "relay", profile.Name)
+	_, err = GetProfile("validator")
+	assert.EqualError(t, err, "unknown profile validator, expected one of participation, api, archival, relay, hybrid-relay")
+}
+
+func Test_DescribeProfile(t *testing.T) {
+	relay, _ := GetProfile("relay")
+	current := MergeAlgodConfigs(Config{Version: ptr(34)}, relay.Config)
+	assert.True(t, relay.Matches(current))
+	assert.Equal(t, "", DescribeProfile(nil, current))
+	assert.Equal(t, "relay", DescribeProfile(&AppliedProfile{Name: "relay"}, current))
+
+	current.NetAddress = ptr(":4161")
+	assert.False(t, relay.Matches(current))
+	assert.Equal(t, "relay (modified)", DescribeProfile(&AppliedProfile{Name: "relay"}, current))
+	assert.Equal(t, "removed (modified)", DescribeProfile(&AppliedProfile{Name: "removed"}, current))
+}
@@ -0,0 +1,80 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+)
+
+// checkRange returns an error when a set value is outside of its range.
+func checkRange[T int | int64 | uint32 | uint64](key string, value *T, low T, high T) error {
+	if value == nil || (*value >= low && *value <= high) {
+		return nil
+	}
+	return fmt.Errorf("%s: %v is not between %v and %v", key, *value, low, high)
+}
+
+// checkMin returns an error when a set value is below its minimum.
+func checkMin[T int | int64 | uint64](key string, value *T, low T) error {
+	if value == nil || *value >= low {
+		return nil
+	}
+	return fmt.Errorf("%s: %v must be at least %v", key, *value, low)
+}
+
+// checkAddress returns an error when a set value is not a host:port address,
+// empty addresses are allowed when they disable the listener.
+func checkAddress(key string, value *string, allowEmpty bool) error {
+	if value == nil || (allowEmpty && *value == "") {
+		return nil
+	}
+	_, port, err := net.SplitHostPort(*value)
+	if err != nil {
+		return fmt.Errorf("%s: %q is not a host:port address", key, *value)
+	}
+	number, err := strconv.Atoi(port)
+	if err != nil || number < 0 || number > 65535 {
+		return fmt.Errorf("%s: %q is not a valid port", key, port)
+	}
+	return nil
+}
+
+// Validate checks the range of the set values and the settings that depend on each other.
+// It returns every problem found, joined in a single error.
+func (c Config) Validate() error {
+	errs := []error{
+		checkMin("CatchupParallelBlocks", c.CatchupParallelBlocks, 1),
+		checkMin("CatchupBlockDownloadRetryAttempts", c.CatchupBlockDownloadRetryAttempts, 1),
+		checkMin("CatchupFailurePeerRefreshRate", c.CatchupFailurePeerRefreshRate, 1),
+		checkRange("CatchpointTracking", c.CatchpointTracking, -1, 2),
+		checkRange("GossipFanout", c.GossipFanout, 1, 64),
+		checkAddress("NetAddress", c.NetAddress, true),
+		checkMin("MaxConnectionsPerIP", c.MaxConnectionsPerIP, 0),
+		checkMin("IncomingConnectionsLimit", c.IncomingConnectionsLimit, -1),
+		checkAddress("EndpointAddress", c.EndpointAddress, false),
+		checkMin("RestReadTimeoutSeconds", c.RestReadTimeoutSeconds, 0),
+		checkMin("RestWriteTimeoutSeconds", c.RestWriteTimeoutSeconds, 0),
+		checkAddress("NodeExporterListenAddress", c.NodeExporterListenAddress, false),
+		checkRange("BaseLoggerDebugLevel", c.BaseLoggerDebugLevel, 0, 5),
+		checkAddress("P2PHybridNetAddress", c.P2PHybridNetAddress, true),
+		checkMin("ProposalAssemblyTime", c.ProposalAssemblyTime, 0),
+		checkMin("TxPoolSize", c.TxPoolSize, 1),
+		checkMin("ParticipationKeysRefreshInterval", c.ParticipationKeysRefreshInterval, 0),
+	}
+	if c.DNSBootstrapID != nil && *c.DNSBootstrapID == "" {
+		errs = append(errs, errors.New("DNSBootstrapID: must not be empty"))
+	}
+	if c.LogArchiveMaxAge != nil && *c.LogArchiveMaxAge != "" {
+		if _, err := time.ParseDuration(*c.LogArchiveMaxAge); err != nil {
+			errs = append(errs, fmt.Errorf("LogArchiveMaxAge: %q is not a duration like 24h", *c.LogArchiveMaxAge))
+		}
+	}
+	hasCert := c.TLSCertFile != nil && *c.TLSCertFile != ""
+	hasKey := c.TLSKeyFile != nil && *c.TLSKeyFile != ""
+	if hasCert != hasKey {
+		errs = append(errs, errors.New("TLSCertFile and TLSKeyFile must be set together"))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,169 @@
+package algod
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// EventType describes the kind of change reported by the StateModel watcher.
+type EventType string
+
+const (
+
+	// StatusChangedEvent is emitted when any field of the status changes, for example from SYNCING to RUNNING
+	// or the progress of a Fast-Catchup.
+	StatusChangedEvent EventType = "status-changed"
+
+	// NewRoundEvent is emitted every time the node reports a new last round.
+	NewRoundEvent EventType = "new-round"
+
+	// KeysChangedEvent is emitted when the participation keys or the accounts derived from them change.
+	KeysChangedEvent EventType = "keys-changed"
+
+	// MetricsUpdatedEvent is emitted after the round averages and RX/TX rates are refreshed.
+	MetricsUpdatedEvent EventType = "metrics-updated"
+
+	// ServiceUpdatedEvent is emitted after the service status of a local node is refreshed.
+	ServiceUpdatedEvent EventType = "service-updated"
+
+	// NodeDownEvent is emitted when the node stops responding, the Err field holds the cause.
+	NodeDownEvent EventType = "node-down"
+
+	// NodeUpEvent is emitted when the node responds again after a NodeDownEvent.
+	NodeUpEvent EventType = "node-up"
+
+	// TickEvent is emitted by the watcher every TickInterval, also while nothing changes,
+	// so subscribers can run time based checks like a stalled node without reading the state concurrently.
+	TickEvent EventType = "tick"
+)
+
+// TickInterval is the minimum time between two TickEvent.
+const TickInterval = 30 * time.Second
+
+// Event is a single notification produced by StateModel.Watch.
+type Event struct {
+	// Type identifies what changed.
+	Type EventType
+
+	// State is a pointer to the watched StateModel at the time of the event.
+	State *StateModel
+
+	// Err is set for NodeDownEvent and holds the error returned by the node.
+	Err error
+}
+
+// subscriberBuffer is the channel buffer given to each subscriber,
+// it allows short bursts of events without blocking the watcher.
+const subscriberBuffer = 16
+
+// subscriber is a single consumer registered with the broker.
+type subscriber struct {
+	// mu serializes sends and the final close of ch
+	mu sync.Mutex
+	ch chan Event
+	// done is closed when the subscription is cancelled,
+	// it releases a publisher blocked on a slow consumer.
+	done chan struct{}
+	once sync.Once
+}
+
+// close cancels the subscription and closes the event channel.
+func (sub *subscriber) close() {
+	sub.once.Do(func() {
+		close(sub.done)
+		sub.mu.Lock()
+		defer sub.mu.Unlock()
+		close(sub.ch)
+	})
+}
+
+// send delivers the event unless the subscription or the context is done first.
+func (sub *subscriber) send(ctx context.Context, event Event) {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+	select {
+	case <-sub.done:
+		return
+	default:
+	}
+	select {
+	case sub.ch <- event:
+	case <-sub.done:
+	case <-ctx.Done():
+	}
+}
+
+// broker fans out events to any number of subscribers.
+type broker struct {
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+	// cancel stops the running watcher, see StateModel.Stop
+	cancel context.CancelFunc
+}
+
+// brokerMu guards the lazy creation of a StateModel broker,
+// StateModel is often built as a literal so the broker cannot be created up front.
+var brokerMu sync.Mutex
+
+// events returns the broker for the StateModel, creating it when missing.
+func (s *StateModel) events() *broker {
+	brokerMu.Lock()
+	defer brokerMu.Unlock()
+	if s.broker == nil {
+		s.broker = &broker{subscribers: make(map[*subscriber]struct{})}
+	}
+	return s.broker
+}
+
+// Subscribe registers a new consumer of watcher events.
+// It returns a receive-only channel and a function to cancel the subscription.
+// The channel is closed when the subscription is cancelled or when Watch returns.
+func (s *StateModel) Subscribe() (<-chan Event, func()) {
+	b := s.events()
+	sub := &subscriber{
+		ch:   make(chan Event, subscriberBuffer),
+		done: make(chan struct{}),
+	}
+
+	b.mu.Lock()
+	b.subscribers[sub] = struct{}{}
+	b.mu.Unlock()
+
+	return sub.ch, func() {
+		b.mu.Lock()
+		delete(b.subscribers, sub)
+		b.mu.Unlock()
+		sub.close()
+	}
+}
+
+// publish sends the event to every subscriber,
+// it blocks on slow consumers until the context is done.
+func (s *StateModel) publish(ctx context.Context, eventType EventType, err error) {
+	b := s.events()
+	b.mu.Lock()
+	subscribers := make([]*subscriber, 0, len(b.subscribers))
+	for sub := range b.subscribers {
+		subscribers = append(subscribers, sub)
+	}
+	b.mu.Unlock()
+
+	event := Event{Type: eventType, State: s, Err: err}
+	for _, sub := range subscribers {
+		sub.send(ctx, event)
+	}
+}
+
+// closeSubscribers cancels every subscription and closes their channels.
+func (s *StateModel) closeSubscribers() {
+	b := s.events()
+	b.mu.Lock()
+	subscribers := b.subscribers
+	b.subscribers = make(map[*subscriber]struct{})
+	b.mu.Unlock()
+
+	for sub := range subscribers {
+		sub.close()
+	}
+}
@@ -12,13 +12,16 @@ import (
 	"syscall"
 )
 
+// UpdateScriptURL is the installer of the Algorand binaries used without a package manager.
+const UpdateScriptURL = "https://raw.githubusercontent.com/algorand/go-algorand/rel/stable/cmd/updater/update.sh"
+
 // Install executes a series of commands to set up the Algorand node and development tools on a Unix environment.
 // TODO: Allow for changing of the paths
 func Install() error {
 	return system.RunAll(system.CmdsList{
 		{"mkdir", "~/node"},
 		{"sh", "-c", "cd ~/node"},
-		{"wget", "https://raw.githubusercontent.com/algorand/go-algorand/rel/stable/cmd/updater/update.sh"},
+		{"wget", UpdateScriptURL},
 		{"chmod", "744", "update.sh"},
 		{"sh", "-c", "./update.sh -i -c stable -p ~/node -d ~/node/data -n"},
 	})
@@ -0,0 +1,101 @@
+package algod
+
+import "time"
+
+// DefaultHistorySize is the number of metric samples kept, roughly three hours with a sample every five rounds.
+const DefaultHistorySize = 720
+
+// Ring is a fixed size buffer that overwrites the oldest value once it is full.
+type Ring[T any] struct {
+	values []T
+	start  int
+	length int
+}
+
+// NewRing creates an empty buffer holding up to size values.
+func NewRing[T any](size int) Ring[T] {
+	return Ring[T]{values: make([]T, max(1, size))}
+}
+
+// Push appends a value, dropping the oldest one when the buffer is full.
+func (r *Ring[T]) Push(value T) {
+	if len(r.values) == 0 {
+		r.values = make([]T, DefaultHistorySize)
+	}
+	if r.length < len(r.values) {
+		r.values[(r.start+r.length)%len(r.values)] = value
+		r.length++
+		return
+	}
+	r.values[r.start] = value
+	r.start = (r.start + 1) % len(r.values)
+}
+
+// Len returns the number of values in the buffer.
+func (r Ring[T]) Len() int {
+	return r.length
+}
+
+// Cap returns the maximum number of values in the buffer.
+func (r Ring[T]) Cap() int {
+	return len(r.values)
+}
+
+// Values returns a copy of the values, oldest first.
+func (r Ring[T]) Values() []T {
+	values := make([]T, r.length)
+	for i := range values {
+		values[i] = r.values[(r.start+i)%len(r.values)]
+	}
+	return values
+}
+
+// MetricsSample is a snapshot of the Metrics at a round.
+type MetricsSample struct {
+	Round     uint64
+	Time      time.Time
+	RoundTime time.Duration
+	TPS       float64
+	RX        int
+	TX        int
+}
+
+// MetricsHistory holds the recent MetricsSample of the node.
+type MetricsHistory struct {
+	Ring[MetricsSample]
+}
+
+// NewMetricsHistory creates an empty history holding up to size samples.
+func NewMetricsHistory(size int) MetricsHistory {
+	return MetricsHistory{NewRing[MetricsSample](size)}
+}
+
+// Series returns one value of every sample, oldest first.
+func (h MetricsHistory) Series(value func(MetricsSample) float64) []float64 {
+	samples := h.Values()
+	series := make([]float64, len(samples))
+	for i, sample := range samples {
+		series[i] = value(sample)
+	}
+	return series
+}
+
+// RoundTimes returns the average round time of every sample in seconds.
+func (h MetricsHistory) RoundTimes() []float64 {
+	return h.Series(func(s MetricsSample) float64 { return s.RoundTime.Seconds() })
+}
+
+// TPS returns the transactions per second of every sample.
+func (h MetricsHistory) TPS() []float64 {
+	return h.Series(func(s MetricsSample) float64 { return s.TPS })
+}
+
+// RX returns the bytes received per second of every sample.
+func (h MetricsHistory) RX() []float64 {
+	return h.Series(func(s MetricsSample) float64 { return float64(s.RX) })
+}
+
+// TX returns the bytes sent per second of every sample.
+func (h MetricsHistory) TX() []float64 {
+	return h.Series(func(s MetricsSample) float64 { return float64(s.TX) })
+}
@@ -0,0 +1,51 @@
+package algod
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Ring(t *testing.T) {
+	ring := NewRing[int](3)
+	assert.Equal(t, 3, ring.Cap())
+	assert.Empty(t, ring.Values())
+
+	ring.Push(1)
+	ring.Push(2)
+	assert.Equal(t, []int{1, 2}, ring.Values())
+
+	ring.Push(3)
+	ring.Push(4)
+	ring.Push(5)
+	assert.Equal(t, 3, ring.Len())
+	assert.Equal(t, []int{3, 4, 5}, ring.Values())
+
+	// The zero value is usable
+	var empty Ring[int]
+	empty.Push(1)
+	assert.Equal(t, []int{1}, empty.Values())
+	assert.Equal(t, DefaultHistorySize, empty.Cap())
+}
+
+func Test_MetricsHistory(t *testing.T) {
+	history := NewMetricsHistory(2)
+	history.Push(MetricsSample{Round: 5, RoundTime: 2 * time.Second, TPS: 1.5, RX: 10, TX: 20})
+	history.Push(MetricsSample{Round: 10, RoundTime: 3 * time.Second, TPS: 2.5, RX: 30, TX: 40})
+	assert.Equal(t, []float64{2, 3}, history.RoundTimes())
+	assert.Equal(t, []float64{1.5, 2.5}, history.TPS())
+	assert.Equal(t, []float64{10, 30}, history.RX())
+	assert.Equal(t, []float64{20, 40}, history.TX())
+
+	// Only the samples after the baseline are kept
+	metrics, _, err := NewMetrics(context.Background(), test.GetClient(false), nil, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, metrics.History.Len())
+	metrics, _, err = metrics.Get(context.Background(), 5)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, metrics.History.Len())
+	assert.Equal(t, uint64(5), metrics.History.Values()[0].Round)
+}
@@ -2,19 +2,27 @@ package linux
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"github.com/algorandfoundation/nodekit/internal/algod/backup"
 	"github.com/algorandfoundation/nodekit/internal/algod/fallback"
 	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/algorandfoundation/nodekit/internal/system/systemd"
 	"github.com/charmbracelet/log"
 	"os"
 	"os/exec"
 	"os/user"
+	"path/filepath"
 	"runtime"
 	"strconv"
 	"strings"
 	"text/template"
 )
 
+// OverrideFilePath is the systemd override of the algorand service written by NodeKit.
+// Assuming that this is the same everywhere systemd is used
+const OverrideFilePath = "/etc/systemd/system/algorand.service.d/override.conf"
+
 // PackageManagerNotFoundMsg is an error message indicating the absence of a supported package manager for uninstalling Algorand.
 const PackageManagerNotFoundMsg = "could not find a package manager to uninstall Algorand"
 
@@ -103,7 +111,11 @@ func Install() error {
 
 // Uninstall removes the Algorand software using a supported package manager or clears related system files if necessary.
 // Returns an error if a supported package manager is not found or if any command fails during execution.
+// A user service is removed without root access.
 func Uninstall() error {
+	if IsUserService() {
+		return UninstallUser()
+	}
 	log.Info("Uninstalling Algorand")
 	var unInstallCmds system.CmdsList
 	// On Ubuntu and Debian there's the apt package manager
@@ -149,35 +161,88 @@ func Upgrade() error {
 	return fmt.Errorf("the *node upgrade* command is currently only available for installations done with an approved package manager. Please use a different method to upgrade")
 }
 
-// Start attempts to start the Algorand service using the system's service manager.
-// It executes the appropriate command for systemd on Linux-based systems.
-// Returns an error if the command fails.
-// TODO: Replace with D-Bus integration
+// UnitName is the systemd unit of the Algorand daemon.
+const UnitName = "algorand.service"
+
+// withSystemd runs an operation on the systemd manager over D-Bus,
+// the manager of the user when algod is installed as a user service.
+// Without a system bus, or when polkit refuses an unprivileged user,
+// the operation falls back to the systemctl command run with sudo.
+func withSystemd(operation func(m *systemd.Manager) error, fallback ...string) error {
+	if IsUserService() {
+		return withUserSystemd(operation, fallback...)
+	}
+	m, err := systemd.ConnectSystem()
+	if err == nil {
+		defer m.Close()
+		err = operation(m)
+		if !errors.Is(err, systemd.ErrAccessDenied) || system.IsSudo() {
+			return err
+		}
+	}
+	log.Debugf("Falling back to systemctl: %s", err)
+	return exec.Command("sudo", append([]string{"systemctl"}, fallback...)...).Run()
+}
+
+// Start attempts to start the Algorand service using the system's service manager
+// and waits for it to be active. Returns an error if the service fails to start.
 func Start() error {
-	return exec.Command("sudo", "systemctl", "start", "algorand").Run()
+	return withSystemd(func(m *systemd.Manager) error {
+		return m.Start(UnitName)
+	}, "start", "algorand")
 }
 
-// Stop shuts down the Algorand algod system process on Linux using the systemctl stop command.
+// Stop shuts down the Algorand algod system process on Linux and waits for the service to be inactive.
 // Returns an error if the operation fails.
-// TODO: Replace with D-Bus integration
 func Stop() error {
-	return exec.Command("sudo", "systemctl", "stop", "algorand").Run()
+	return withSystemd(func(m *systemd.Manager) error {
+		return m.Stop(UnitName)
+	}, "stop", "algorand")
 }
 
-// IsService checks if the "algorand.service" is listed as a systemd unit file on Linux.
-// Returns true if it exists.
-// TODO: Replace with D-Bus integration
+// IsService checks if the "algorand.service" has a systemd unit file on Linux,
+// for the system or the user. Returns true if it exists.
 func IsService() bool {
-	out, err := system.Run([]string{"sudo", "systemctl", "list-unit-files", "algorand.service"})
+	if IsUserService() {
+		return true
+	}
+	m, err := systemd.ConnectSystem()
 	if err != nil {
-		return false
+		out, err := system.Run([]string{"sudo", "systemctl", "list-unit-files", UnitName})
+		return err == nil && strings.Contains(out, UnitName)
 	}
-	return strings.Contains(out, "algorand.service")
+	defer m.Close()
+	exists, err := m.Exists(UnitName)
+	return err == nil && exists
+}
+
+// Status returns the state of the Algorand service and of its main process.
+func Status() (systemd.UnitStatus, error) {
+	connect := systemd.ConnectSystem
+	if IsUserService() {
+		connect = systemd.ConnectUser
+	}
+	m, err := connect()
+	if err != nil {
+		return systemd.UnitStatus{Name: UnitName}, err
+	}
+	defer m.Close()
+	return m.Status(UnitName)
+}
+
+// ReloadService reloads the systemd manager configuration, applying the changes of the override file.
+func ReloadService() error {
+	return withSystemd(func(m *systemd.Manager) error {
+		return m.Reload()
+	}, "daemon-reload")
 }
 
 // UpdateService updates the systemd service file for the Algorand daemon
 // with a new data directory path and reloads the daemon.
 func UpdateService(dataDirectoryPath string) error {
+	if IsUserService() {
+		return updateUserService(dataDirectoryPath)
+	}
 
 	algodPath, err := exec.LookPath("algod")
 	if err != nil {
@@ -185,12 +250,8 @@ func UpdateService(dataDirectoryPath string) error {
 		os.Exit(1)
 	}
 
-	// Path to the systemd service override file
-	// Assuming that this is the same everywhere systemd is used
-	overrideFilePath := "/etc/systemd/system/algorand.service.d/override.conf"
-
 	// Create the override directory if it doesn't exist
-	err = os.MkdirAll("/etc/systemd/system/algorand.service.d", 0755)
+	err = os.MkdirAll(filepath.Dir(OverrideFilePath), 0755)
 	if err != nil {
 		fmt.Printf("Failed to create override directory: %v\n", err)
 		os.Exit(1)
@@ -223,16 +284,22 @@ ExecStart={{.AlgodPath}} -d {{.DataDirectoryPath}}`
 		os.Exit(1)
 	}
 
+	// Save the previous override in the history of the data directory
+	_, err = backup.Snapshot(dataDirectoryPath, OverrideFilePath)
+	if err != nil {
+		fmt.Printf("Failed to save the previous override file: %v\n", err)
+		os.Exit(1)
+	}
+
 	// Write the override content to the file
-	err = os.WriteFile(overrideFilePath, overrideContent.Bytes(), 0644)
+	err = os.WriteFile(OverrideFilePath, overrideContent.Bytes(), 0644)
 	if err != nil {
 		fmt.Printf("Failed to write override file: %v\n", err)
 		os.Exit(1)
 	}
 
 	// Reload systemd manager configuration
-	cmd := exec.Command("systemctl", "daemon-reload")
-	err = cmd.Run()
+	err = ReloadService()
 	if err != nil {
 		fmt.Printf("Failed to reload systemd daemon: %v\n", err)
 		os.Exit(1)
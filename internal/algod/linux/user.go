@@ -0,0 +1,331 @@
+package linux
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/algorandfoundation/nodekit/internal/algod/backup"
+	"github.com/algorandfoundation/nodekit/internal/algod/fallback"
+	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/algorandfoundation/nodekit/internal/system/systemd"
+	"github.com/charmbracelet/log"
+)
+
+// UserNodeDirname is the directory of a user service in the home of the user,
+// it holds the binaries in bin and the data directory in data.
+const UserNodeDirname = "node"
+
+// LingerDir holds a file for each user whose services keep running after they log out.
+const LingerDir = "/var/lib/systemd/linger"
+
+// userUnitTemplate is the unit of the Algorand daemon run by the systemd instance of the user.
+const userUnitTemplate = `[Unit]
+Description=Algorand daemon {{.AlgodPath}} in {{.DataDirectoryPath}}
+
+[Service]
+ExecStart={{.AlgodPath}} -d {{.DataDirectoryPath}}
+Restart=always
+RestartSec=5s
+
+[Install]
+WantedBy=default.target
+`
+
+// UserNodeDir returns the directory of the binaries and data of a user service, ~/node.
+func UserNodeDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, UserNodeDirname), nil
+}
+
+// UserUnitPath returns the unit file of a user service, in the systemd directory of the user configuration.
+func UserUnitPath() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, "systemd", "user", UnitName), nil
+}
+
+// systemUnitDirs are the directories of the system units installed by the packages or by hand.
+var systemUnitDirs = []string{"/etc/systemd/system", "/lib/systemd/system", "/usr/lib/systemd/system"}
+
+// bothServicesOnce warns a single time per run when the user and the system services both exist.
+var bothServicesOnce sync.Once
+
+// hasUserUnit checks if the unit of a user service exists.
+func hasUserUnit() bool {
+	path, err := UserUnitPath()
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(path)
+	return err == nil
+}
+
+// hasSystemUnit checks if the unit of the system service exists.
+func hasSystemUnit() bool {
+	for _, dir := range systemUnitDirs {
+		if _, err := os.Stat(filepath.Join(dir, UnitName)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// IsUserService reports whether algod is installed as a service of the user instead of the system.
+// The system service is preferred when both exist, the user is told which one is used.
+func IsUserService() bool {
+	if !hasUserUnit() {
+		return false
+	}
+	if hasSystemUnit() {
+		bothServicesOnce.Do(func() {
+			path, _ := UserUnitPath()
+			dataDir, _ := GetUserServiceDataDir()
+			log.Warnf("Both the system %s and the user service %s exist, using the system service, "+
+				"the data directory %s of the user service is ignored until %s is removed", UnitName, path, dataDir, path)
+		})
+		return false
+	}
+	return true
+}
+
+// GetUserServiceDataDir returns the data directory of the algod started by the user service.
+func GetUserServiceDataDir() (string, error) {
+	path, err := UserUnitPath()
+	if err != nil {
+		return "", err
+	}
+	unit, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return parseDataDir(unit)
+}
+
+// execStart returns the arguments of the ExecStart of a unit, the algod binary first.
+func execStart(unit []byte) []string {
+	scanner := bufio.NewScanner(bytes.NewReader(unit))
+	for scanner.Scan() {
+		command, ok := strings.CutPrefix(strings.TrimSpace(scanner.Text()), "ExecStart=")
+		if args := strings.Fields(command); ok && len(args) > 0 {
+			return args
+		}
+	}
+	return nil
+}
+
+// parseDataDir returns the -d argument of the ExecStart of a unit.
+func parseDataDir(unit []byte) (string, error) {
+	args := execStart(unit)
+	for i, arg := range args {
+		if arg == "-d" && i+1 < len(args) {
+			return args[i+1], nil
+		}
+	}
+	return "", errors.New("the user service has no data directory")
+}
+
+// userUnit renders the unit of a user service starting algodPath on dataDir.
+func userUnit(algodPath string, dataDir string) ([]byte, error) {
+	tmpl, err := template.New("unit").Parse(userUnitTemplate)
+	if err != nil {
+		return nil, err
+	}
+	var unit bytes.Buffer
+	err = tmpl.Execute(&unit, map[string]string{
+		"AlgodPath":         algodPath,
+		"DataDirectoryPath": dataDir,
+	})
+	return unit.Bytes(), err
+}
+
+// writeUserUnit writes the unit of a user service, saving the previous unit in the history of the data directory.
+func writeUserUnit(algodPath string, dataDir string) error {
+	path, err := UserUnitPath()
+	if err != nil {
+		return err
+	}
+	unit, err := userUnit(algodPath, dataDir)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+	_, err = backup.Snapshot(dataDir, path)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, unit, 0644)
+}
+
+// withUserSystemd runs an operation on the systemd instance of the user over D-Bus,
+// falling back to systemctl --user without a session bus, like in a shell started by su.
+func withUserSystemd(operation func(m *systemd.Manager) error, fallback ...string) error {
+	m, err := systemd.ConnectUser()
+	if err == nil {
+		defer m.Close()
+		return operation(m)
+	}
+	log.Debugf("Falling back to systemctl --user: %s", err)
+	return exec.Command("systemctl", append([]string{"--user"}, fallback...)...).Run()
+}
+
+// InstallUser installs the Algorand binaries in ~/node/bin with a data directory in ~/node/data,
+// and runs algod as a service of the systemd instance of the user, no root access is needed.
+// With linger, the service keeps running after the user logs out and starts on boot.
+func InstallUser(linger bool) error {
+	if !system.CmdExists("systemctl") {
+		return errors.New("a user service requires systemd")
+	}
+	nodeDir, err := UserNodeDir()
+	if err != nil {
+		return err
+	}
+	binDir := filepath.Join(nodeDir, "bin")
+	dataDir := filepath.Join(nodeDir, "data")
+	script := filepath.Join(binDir, "update.sh")
+
+	log.Infof("Installing Algod in %s", nodeDir)
+	err = system.RunAll(system.CmdsList{
+		{"mkdir", "-p", binDir, dataDir},
+		{"curl", "-fsSL", "-o", script, fallback.UpdateScriptURL},
+		{"chmod", "744", script},
+		{script, "-i", "-c", "stable", "-p", binDir, "-d", dataDir, "-n"},
+	})
+	if err != nil {
+		return err
+	}
+
+	err = writeUserUnit(filepath.Join(binDir, "algod"), dataDir)
+	if err != nil {
+		return err
+	}
+	err = withUserSystemd(func(m *systemd.Manager) error {
+		if err := m.Reload(); err != nil {
+			return err
+		}
+		if err := m.Enable(UnitName); err != nil {
+			return err
+		}
+		return m.Start(UnitName)
+	}, "enable", "--now", UnitName)
+	if err != nil {
+		return err
+	}
+
+	if linger {
+		return EnableLinger()
+	}
+	if !IsLingering() {
+		log.Warn("The node stops when you log out, keep it running with *loginctl enable-linger*")
+	}
+	return nil
+}
+
+// UninstallUser stops and removes the user service and the binaries in ~/node/bin, the data directory is kept.
+func UninstallUser() error {
+	path, err := UserUnitPath()
+	if err != nil {
+		return err
+	}
+	unit, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	dataDir, err := parseDataDir(unit)
+	if err != nil {
+		return err
+	}
+	algodPath := execStart(unit)[0]
+
+	log.Info("Uninstalling the Algorand user service")
+	err = withUserSystemd(func(m *systemd.Manager) error {
+		if err := m.Stop(UnitName); err != nil {
+			return err
+		}
+		return m.Disable(UnitName)
+	}, "disable", "--now", UnitName)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(path)
+	if err != nil {
+		return err
+	}
+	err = withUserSystemd(func(m *systemd.Manager) error {
+		return m.Reload()
+	}, "daemon-reload")
+	if err != nil {
+		return err
+	}
+
+	// Only remove the binaries installed by InstallUser
+	nodeDir, err := UserNodeDir()
+	if err == nil && algodPath == filepath.Join(nodeDir, "bin", "algod") {
+		err = os.RemoveAll(filepath.Dir(algodPath))
+		if err != nil {
+			return err
+		}
+	}
+	log.Infof("The data directory %s was kept", dataDir)
+	if IsLingering() {
+		log.Info("Lingering is still enabled, disable it with *loginctl disable-linger*")
+	}
+	return nil
+}
+
+// updateUserService rewrites the user service for a new data directory and reloads it.
+func updateUserService(dataDirectoryPath string) error {
+	path, err := UserUnitPath()
+	if err != nil {
+		return err
+	}
+	unit, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	args := execStart(unit)
+	if len(args) == 0 {
+		return errors.New("the user service has no ExecStart")
+	}
+	err = writeUserUnit(args[0], dataDirectoryPath)
+	if err != nil {
+		return err
+	}
+	return ReloadService()
+}
+
+// EnableLinger keeps the services of the user running after they log out, and starts them on boot.
+func EnableLinger() error {
+	output, err := system.Run([]string{"loginctl", "enable-linger"})
+	if err != nil {
+		return fmt.Errorf("failed to enable lingering: %s", strings.TrimSpace(output))
+	}
+	log.Info("Lingering enabled, the node keeps running after you log out")
+	return nil
+}
+
+// IsLingering reports whether the services of the current user keep running after they log out.
+func IsLingering() bool {
+	current, err := user.Current()
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(filepath.Join(LingerDir, current.Username))
+	return err == nil
+}
@@ -0,0 +1,71 @@
+package linux
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/algorandfoundation/nodekit/internal/algod/backup"
+	"github.com/stretchr/testify/assert"
+)
+
+// setSystemUnitDir replaces the system unit directories for the test.
+func setSystemUnitDir(t *testing.T, dir string) {
+	dirs := systemUnitDirs
+	systemUnitDirs = []string{dir}
+	t.Cleanup(func() { systemUnitDirs = dirs })
+}
+
+func Test_UserUnit(t *testing.T) {
+	unit, err := userUnit("/home/alice/node/bin/algod", "/home/alice/node/data")
+	assert.Nil(t, err)
+	assert.Contains(t, string(unit), "ExecStart=/home/alice/node/bin/algod -d /home/alice/node/data\n")
+	assert.Contains(t, string(unit), "Restart=always\n")
+	assert.Contains(t, string(unit), "WantedBy=default.target\n")
+	// The user instance of systemd has no network-online.target
+	assert.NotContains(t, string(unit), "network-online.target")
+
+	assert.Equal(t, []string{"/home/alice/node/bin/algod", "-d", "/home/alice/node/data"}, execStart(unit))
+	dataDir, err := parseDataDir(unit)
+	assert.Nil(t, err)
+	assert.Equal(t, "/home/alice/node/data", dataDir)
+
+	_, err = parseDataDir([]byte("[Service]\nExecStart=/usr/bin/algod\n"))
+	assert.EqualError(t, err, "the user service has no data directory")
+}
+
+func Test_UserService(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	setSystemUnitDir(t, t.TempDir())
+	dataDir := t.TempDir()
+	assert.False(t, IsUserService())
+
+	path, err := UserUnitPath()
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(os.Getenv("XDG_CONFIG_HOME"), "systemd", "user", "algorand.service"), path)
+
+	assert.Nil(t, writeUserUnit("/opt/node/bin/algod", dataDir))
+	assert.True(t, IsUserService())
+	resolved, err := GetUserServiceDataDir()
+	assert.Nil(t, err)
+	assert.Equal(t, dataDir, resolved)
+
+	// The previous unit is saved in the history of the data directory
+	assert.Nil(t, writeUserUnit("/opt/node/bin/algod", dataDir))
+	versions, err := backup.List(dataDir)
+	assert.Nil(t, err)
+	assert.Len(t, versions, 2)
+	assert.Equal(t, []backup.File{{Path: path, Exists: true}}, versions[1].Files)
+}
+
+func Test_BothServices(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	systemDir := t.TempDir()
+	setSystemUnitDir(t, systemDir)
+	assert.Nil(t, writeUserUnit("/opt/node/bin/algod", t.TempDir()))
+	assert.True(t, IsUserService())
+
+	// The system service is preferred over the user service
+	assert.Nil(t, os.WriteFile(filepath.Join(systemDir, UnitName), []byte("[Service]\n"), 0o644))
+	assert.False(t, IsUserService())
+}
@@ -4,9 +4,6 @@ import (
 	"context"
 	"errors"
 	"github.com/algorandfoundation/nodekit/api"
-	"regexp"
-	"strconv"
-	"strings"
 	"time"
 )
 
@@ -50,6 +47,10 @@ type Metrics struct {
 	// last metrics update, used for TX rate calculation.
 	LastTX int
 
+	// History holds the recent samples of the metrics, oldest first,
+	// used for rendering the trends of the node.
+	History MetricsHistory
+
 	// Client provides an interface for interacting with API endpoints,
 	// enabling metrics retrieval and other operations.
 	Client api.ClientWithResponsesInterface
@@ -59,45 +60,6 @@ type Metrics struct {
 	HttpPkg api.HttpPkgInterface
 }
 
-// MetricsResponse represents a mapping of metric names to their integer values.
-type MetricsResponse map[string]int
-
-// parseMetricsContent parses Prometheus-style metrics content and returns a mapping of metric names to their integer values.
-// It validates the input format, extracts key-value pairs, and handles errors during parsing.
-func parseMetricsContent(content string) (MetricsResponse, error) {
-	var err error
-	result := MetricsResponse{}
-
-	// Validate the Content
-	var isValid bool
-	isValid, err = regexp.MatchString(`^#`, content)
-	isValid = isValid && err == nil && content != ""
-	if !isValid {
-		return nil, errors.New("invalid metrics content")
-	}
-
-	// Regex for Metrics Format,
-	// selects all content that does not start with # in multiline mode
-	re := regexp.MustCompile(`(?m)^[^#].*`)
-	rows := re.FindAllString(content, -1)
-
-	// Add the strings to the map
-	for _, row := range rows {
-		var value int
-		keyValue := strings.Split(row, " ")
-		value, err = strconv.Atoi(keyValue[1])
-		result[keyValue[0]] = value
-	}
-
-	// Handle any error results
-	if err != nil {
-		return nil, err
-	}
-
-	// Give the user what they asked for
-	return result, nil
-}
-
 // Get retrieves metrics data, processes network statistics,
 // calculates TPS and round time, and updates the Metrics state.
 func (m Metrics) Get(ctx context.Context, currentRound uint64) (Metrics, api.ResponseInterface, error) {
@@ -123,13 +85,17 @@ func (m Metrics) Get(ctx context.Context, currentRound uint64) (Metrics, api.Res
 	m.Enabled = true
 	now := time.Now()
 	diff := now.Sub(m.LastTS)
+	// The first sample only sets the baseline of the RX/TX rates
+	isBaseline := m.LastTS.IsZero()
 
-	m.TX = max(0, int(float64(content["algod_network_sent_bytes_total"]-m.LastTX)/diff.Seconds()))
-	m.RX = max(0, int(float64(content["algod_network_received_bytes_total"]-m.LastRX)/diff.Seconds()))
+	sent := int(content.Sum("algod_network_sent_bytes_total", nil))
+	received := int(content.Sum("algod_network_received_bytes_total", nil))
+	m.TX = max(0, int(float64(sent-m.LastTX)/diff.Seconds()))
+	m.RX = max(0, int(float64(received-m.LastRX)/diff.Seconds()))
 
 	m.LastTS = now
-	m.LastTX = content["algod_network_sent_bytes_total"]
-	m.LastRX = content["algod_network_received_bytes_total"]
+	m.LastTX = sent
+	m.LastRX = received
 
 	if int(currentRound) > m.Window {
 		var blockMetrics BlockMetrics
@@ -142,6 +108,17 @@ func (m Metrics) Get(ctx context.Context, currentRound uint64) (Metrics, api.Res
 		m.RoundTime = blockMetrics.AvgTime
 	}
 
+	if !isBaseline {
+		m.History.Push(MetricsSample{
+			Round:     currentRound,
+			Time:      now,
+			RoundTime: m.RoundTime,
+			TPS:       m.TPS,
+			RX:        m.RX,
+			TX:        m.TX,
+		})
+	}
+
 	return m, response, nil
 }
 
@@ -158,6 +135,7 @@ func NewMetrics(ctx context.Context, client api.ClientWithResponsesInterface, ht
 		TX:        0,
 		LastTS:    time.Time{},
 		LastRX:    0,
+		History:   NewMetricsHistory(DefaultHistorySize),
 
 		Client:  client,
 		HttpPkg: httpPkg,
@@ -4,6 +4,8 @@ import (
 	"context"
 	"github.com/algorandfoundation/nodekit/api"
 	"github.com/algorandfoundation/nodekit/internal/test"
+	"github.com/stretchr/testify/assert"
+	"math"
 	"strconv"
 	"testing"
 )
@@ -55,8 +57,8 @@ algod_crypto_vrf_hash_total 0
 		t.Fatal(err)
 	}
 
-	if metrics["algod_telemetry_drops_total"] != 0 {
-		t.Fatal(strconv.Itoa(metrics["algod_telemetry_drops_total"]) + " is not 0")
+	if value, ok := metrics.Value("algod_telemetry_drops_total", nil); !ok || value != 0 {
+		t.Fatal(strconv.FormatFloat(value, 'f', -1, 64) + " is not 0")
 	}
 
 	content = `INVALID`
@@ -67,9 +69,82 @@ algod_crypto_vrf_hash_total 0
 
 	content = `# HELP algod_telemetry_drops_total telemetry messages dropped due to full queues
 # TYPE algod_telemetry_drops_total counter
-algod_telemetry_drops_total NAN`
+algod_telemetry_drops_total ZERO`
 	_, err = parseMetricsContent(content)
 	if err == nil {
 		t.Fatal(err)
 	}
 }
+
+func Test_parseLabelledMetrics(t *testing.T) {
+	content := `# HELP algod_network_peers Number of peers
+# TYPE algod_network_peers gauge
+algod_network_peers{type="outgoing"} 4
+algod_network_peers{type="incoming", role="relay"} 12 1700000000000
+# comment without a type
+algod_ledger_round 4.2e+07
+algod_agreement_seconds{step="propose",escaped="a \"quoted\" \\ value\n"} NaN
+# HELP algod_txpool_latency Transaction pool latency \\ seconds
+# TYPE algod_txpool_latency histogram
+algod_txpool_latency_bucket{le="0.5"} 3
+algod_txpool_latency_bucket{le="+Inf"} 5
+algod_txpool_latency_sum 1.25
+algod_txpool_latency_count 5
+`
+	metrics, err := parseMetricsContent(content)
+	assert.Nil(t, err)
+
+	peers := metrics["algod_network_peers"]
+	assert.Equal(t, GaugeMetric, peers.Type)
+	assert.Equal(t, "Number of peers", peers.Help)
+	assert.Len(t, peers.Samples, 2)
+	assert.Equal(t, float64(16), metrics.Sum("algod_network_peers", nil))
+	value, ok := metrics.Value("algod_network_peers", map[string]string{"type": "incoming"})
+	assert.True(t, ok)
+	assert.Equal(t, float64(12), value)
+	assert.Equal(t, int64(1700000000000), peers.Samples[1].Timestamp)
+	assert.Equal(t, "relay", peers.Samples[1].Labels["role"])
+	_, ok = metrics.Value("algod_network_peers", map[string]string{"type": "unknown"})
+	assert.False(t, ok)
+
+	// Samples without a type are untyped families
+	value, ok = metrics.Value("algod_ledger_round", nil)
+	assert.True(t, ok)
+	assert.Equal(t, float64(42_000_000), value)
+	assert.Equal(t, UntypedMetric, metrics["algod_ledger_round"].Type)
+
+	agreement := metrics.Find("algod_agreement_seconds", nil)
+	assert.Len(t, agreement, 1)
+	assert.True(t, math.IsNaN(agreement[0].Value))
+	assert.Equal(t, "a \"quoted\" \\ value\n", agreement[0].Labels["escaped"])
+
+	latency := metrics["algod_txpool_latency"]
+	assert.Equal(t, HistogramMetric, latency.Type)
+	assert.Equal(t, "Transaction pool latency \\ seconds", latency.Help)
+	assert.Len(t, latency.Samples, 4)
+	value, ok = metrics.Value("algod_txpool_latency_bucket", map[string]string{"le": "+Inf"})
+	assert.True(t, ok)
+	assert.Equal(t, float64(5), value)
+	assert.Equal(t, 1.25, metrics.Sum("algod_txpool_latency_sum", nil))
+
+	// Names found in "# HELP" keep their own help
+	for _, name := range []string{"H", "E", "L", "P", "HELP"} {
+		metrics, err = parseMetricsContent("# HELP " + name + " Help of " + name + "\n" + name + " 1\n")
+		assert.Nil(t, err)
+		assert.Equal(t, "Help of "+name, metrics[name].Help)
+	}
+	metrics, err = parseMetricsContent("# HELP empty\nempty 1\n")
+	assert.Nil(t, err)
+	assert.Equal(t, "", metrics["empty"].Help)
+
+	for _, invalid := range []string{
+		"# TYPE\n1metric 1",
+		"# TYPE a gauge\na{type=\"in\" 1",
+		"# TYPE a gauge\na{type=in} 1",
+		"# TYPE a gauge\na{} 1 now",
+		"# TYPE a gauge\na 1 2 3",
+	} {
+		_, err = parseMetricsContent(invalid)
+		assert.NotNil(t, err, invalid)
+	}
+}
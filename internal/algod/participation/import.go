@@ -0,0 +1,60 @@
+package participation
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/algorandfoundation/nodekit/api"
+)
+
+// FileExtension is the extension of the participation key files created by algokey and goal.
+const FileExtension = ".partkey"
+
+// Validate checks the key belongs to the address, when one is given, and can still vote after the last round.
+func Validate(key api.ParticipationKey, address string, lastRound int) error {
+	if address != "" && key.Address != address {
+		return fmt.Errorf("participation key is for %s, expected %s", key.Address, address)
+	}
+	if key.Key.VoteLastValid <= key.Key.VoteFirstValid {
+		return fmt.Errorf("participation key has an invalid range %d-%d", key.Key.VoteFirstValid, key.Key.VoteLastValid)
+	}
+	if key.Key.VoteLastValid <= lastRound {
+		return fmt.Errorf("participation key expired at round %d, the node is at round %d", key.Key.VoteLastValid, lastRound)
+	}
+	return nil
+}
+
+// Import uploads a participation key file to the node and returns the key.
+// The key is validated against the last round of the node and removed again when it is not valid.
+func Import(ctx context.Context, client api.ClientWithResponsesInterface, file []byte, address string) (*api.ParticipationKey, error) {
+	status, err := client.GetStatusWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if status.StatusCode() != 200 {
+		return nil, errors.New(status.Status())
+	}
+
+	added, err := client.AddParticipationKeyWithBodyWithResponse(ctx, "application/msgpack", bytes.NewReader(file))
+	if err != nil {
+		return nil, err
+	}
+	if added.StatusCode() != 200 {
+		if added.JSON400 != nil {
+			return nil, errors.New(added.JSON400.Message)
+		}
+		return nil, errors.New(added.Status())
+	}
+
+	key, _, err := GetKey(ctx, client, added.JSON200.PartId)
+	if err != nil {
+		return nil, err
+	}
+	err = Validate(*key, address, status.JSON200.LastRound)
+	if err != nil {
+		return nil, errors.Join(err, Delete(ctx, client, key.Id))
+	}
+	return key, nil
+}
@@ -0,0 +1,34 @@
+package participation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/algorandfoundation/nodekit/internal/test"
+	"github.com/algorandfoundation/nodekit/internal/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Validate(t *testing.T) {
+	key := mock.Keys[0]
+	assert.Nil(t, Validate(key, "", 10))
+	assert.Nil(t, Validate(key, "ABC", 10))
+	assert.ErrorContains(t, Validate(key, "DEF", 10), "expected DEF")
+	assert.ErrorContains(t, Validate(key, "", 30000), "expired")
+
+	key.Key.VoteFirstValid = 30000
+	assert.ErrorContains(t, Validate(key, "", 10), "invalid range")
+}
+
+func Test_Import(t *testing.T) {
+	ctx := context.Background()
+	key, err := Import(ctx, test.GetClient(false), []byte("partkey"), "ABC")
+	assert.Nil(t, err)
+	assert.Equal(t, mock.Keys[0].Id, key.Id)
+
+	_, err = Import(ctx, test.GetClient(false), []byte("partkey"), "DEF")
+	assert.ErrorContains(t, err, "expected DEF")
+
+	_, err = Import(ctx, test.NewClient(false, true), []byte("partkey"), "")
+	assert.EqualError(t, err, "participation key already exists")
+}
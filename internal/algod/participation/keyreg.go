@@ -0,0 +1,69 @@
+package participation
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/v2/encoding/json"
+	"github.com/algorand/go-algorand-sdk/v2/encoding/msgpack"
+	"github.com/algorand/go-algorand-sdk/v2/transaction"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+	"github.com/algorandfoundation/nodekit/api"
+)
+
+// IncentiveEligibilityFee is the fee in microAlgos an online keyreg pays to make the account eligible for incentives.
+const IncentiveEligibilityFee = 2_000_000
+
+// TxnFormat is the encoding of an unsigned transaction file.
+type TxnFormat string
+
+const (
+	// MsgpackFormat is the canonical msgpack encoding used by `goal clerk sign` and the SDKs.
+	MsgpackFormat TxnFormat = "msgpack"
+
+	// JSONFormat is the human-readable JSON encoding of the transaction.
+	JSONFormat TxnFormat = "json"
+)
+
+// MakeOnlineKeyRegTxn builds the online key registration transaction for the participation key.
+// The incentive eligibility fee is used as a flat fee when incentivesFee is set.
+func MakeOnlineKeyRegTxn(part api.ParticipationKey, params types.SuggestedParams, incentivesFee bool) (types.Transaction, error) {
+	if part.Key.StateProofKey == nil {
+		return types.Transaction{}, fmt.Errorf("participation key %s is missing a state proof key", part.Id)
+	}
+	if incentivesFee {
+		params.FlatFee = true
+		params.Fee = IncentiveEligibilityFee
+	}
+	return transaction.MakeKeyRegTxnWithStateProofKey(
+		part.Address,
+		nil,
+		params,
+		base64.StdEncoding.EncodeToString(part.Key.VoteParticipationKey),
+		base64.StdEncoding.EncodeToString(part.Key.SelectionParticipationKey),
+		base64.StdEncoding.EncodeToString(*part.Key.StateProofKey),
+		uint64(part.Key.VoteFirstValid),
+		uint64(part.Key.VoteLastValid),
+		uint64(part.Key.VoteKeyDilution),
+		false,
+	)
+}
+
+// MakeOfflineKeyRegTxn builds the offline key registration transaction for the address.
+func MakeOfflineKeyRegTxn(address string, params types.SuggestedParams) (types.Transaction, error) {
+	return transaction.MakeKeyRegTxnWithStateProofKey(address, nil, params, "", "", "", 0, 0, 0, false)
+}
+
+// EncodeUnsignedTxn encodes the transaction as an unsigned transaction file in the format.
+// The transaction is wrapped in an empty signed transaction, like the files created by `goal clerk`.
+func EncodeUnsignedTxn(txn types.Transaction, format TxnFormat) ([]byte, error) {
+	stx := types.SignedTxn{Txn: txn}
+	switch format {
+	case MsgpackFormat:
+		return msgpack.Encode(stx), nil
+	case JSONFormat:
+		return json.Encode(stx), nil
+	default:
+		return nil, fmt.Errorf("unsupported transaction format %s", format)
+	}
+}
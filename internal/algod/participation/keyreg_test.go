@@ -0,0 +1,100 @@
+package participation
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/v2/encoding/msgpack"
+	"github.com/algorand/go-algorand-sdk/v2/transaction"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+	"github.com/algorandfoundation/nodekit/api"
+)
+
+const testAddress = "QNZ7GONNHTNXFW56Y24CNJQEMYKZKKI566ASNSWPD24VSGKJWHGO6QOP7U"
+
+func getKeyregParams() types.SuggestedParams {
+	return types.SuggestedParams{
+		Fee:             0,
+		GenesisID:       "testnet-v1.0",
+		GenesisHash:     make([]byte, 32),
+		FirstRoundValid: 10,
+		LastRoundValid:  1010,
+	}
+}
+
+func getKeyregKey() api.ParticipationKey {
+	stateProofKey := bytes.Repeat([]byte{3}, 64)
+	return api.ParticipationKey{
+		Address: testAddress,
+		Id:      "ID",
+		Key: api.AccountParticipation{
+			SelectionParticipationKey: bytes.Repeat([]byte{1}, 32),
+			VoteParticipationKey:      bytes.Repeat([]byte{2}, 32),
+			StateProofKey:             &stateProofKey,
+			VoteFirstValid:            10,
+			VoteLastValid:             3_000_010,
+			VoteKeyDilution:           1733,
+		},
+	}
+}
+
+func Test_MakeOnlineKeyRegTxn(t *testing.T) {
+	txn, err := MakeOnlineKeyRegTxn(getKeyregKey(), getKeyregParams(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if txn.Type != types.KeyRegistrationTx || txn.Fee != transaction.MinTxnFee {
+		t.Errorf("unexpected transaction %v", txn)
+	}
+	if txn.VoteLast != 3_000_010 || txn.VoteKeyDilution != 1733 || txn.VotePK[0] != 2 {
+		t.Error("expected the key fields to be set")
+	}
+
+	txn, err = MakeOnlineKeyRegTxn(getKeyregKey(), getKeyregParams(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if txn.Fee != IncentiveEligibilityFee {
+		t.Errorf("expected the incentive fee, got %d", txn.Fee)
+	}
+
+	key := getKeyregKey()
+	key.Key.StateProofKey = nil
+	_, err = MakeOnlineKeyRegTxn(key, getKeyregParams(), false)
+	if err == nil {
+		t.Error("expected an error without a state proof key")
+	}
+}
+
+func Test_EncodeUnsignedTxn(t *testing.T) {
+	txn, err := MakeOfflineKeyRegTxn(testAddress, getKeyregParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if txn.VoteLast != 0 || txn.VotePK != (types.VotePK{}) {
+		t.Error("expected an offline keyreg")
+	}
+
+	b, err := EncodeUnsignedTxn(txn, MsgpackFormat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var stx types.SignedTxn
+	err = msgpack.Decode(b, &stx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stx.Txn.Sender.String() != testAddress {
+		t.Errorf("unexpected sender %s", stx.Txn.Sender.String())
+	}
+
+	b, err = EncodeUnsignedTxn(txn, JSONFormat)
+	if err != nil || !bytes.Contains(b, []byte(`"keyreg"`)) {
+		t.Errorf("unexpected json %s", b)
+	}
+
+	_, err = EncodeUnsignedTxn(txn, "xml")
+	if err == nil {
+		t.Error("expected an unsupported format error")
+	}
+}
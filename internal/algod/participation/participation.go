@@ -3,9 +3,11 @@ package participation
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/algorandfoundation/nodekit/api"
@@ -201,6 +203,29 @@ type OnlineShortLinkBody struct {
 	Network          string `json:"network"`
 }
 
+// ShortLinkNetwork converts an algod network name into the network name used by the short link service.
+func ShortLinkNetwork(network string) string {
+	var loraNetwork = strings.Replace(strings.Replace(network, "-v1.0", "", 1), "-v1", "", 1)
+	if loraNetwork == "dockernet" || loraNetwork == "tuinet" {
+		loraNetwork = "localnet"
+	}
+	return loraNetwork
+}
+
+// NewOnlineShortLinkBody creates the online short link request for a participation key on the algod network.
+func NewOnlineShortLinkBody(part api.ParticipationKey, network string) OnlineShortLinkBody {
+	return OnlineShortLinkBody{
+		Account:          part.Address,
+		VoteKeyB64:       base64.RawURLEncoding.EncodeToString(part.Key.VoteParticipationKey),
+		SelectionKeyB64:  base64.RawURLEncoding.EncodeToString(part.Key.SelectionParticipationKey),
+		StateProofKeyB64: base64.RawURLEncoding.EncodeToString(*part.Key.StateProofKey),
+		VoteFirstValid:   part.Key.VoteFirstValid,
+		VoteLastValid:    part.Key.VoteLastValid,
+		KeyDilution:      part.Key.VoteKeyDilution,
+		Network:          ShortLinkNetwork(network),
+	}
+}
+
 // GetOnlineShortLink sends a POST request to create an online short link
 // and returns the response or an error if it occurs.
 func GetOnlineShortLink(http api.HttpPkgInterface, part OnlineShortLinkBody) (ShortLinkResponse, error) {
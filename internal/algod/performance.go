@@ -0,0 +1,170 @@
+package algod
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/api"
+)
+
+// supplyInterval is how many rounds the online stake is reused before it is fetched again.
+const supplyInterval = 100
+
+// maxScanRounds limits how many missed rounds Update scans at once, older rounds are skipped.
+const maxScanRounds = 20
+
+// Proposal is the proposer information of a block.
+type Proposal struct {
+	Round uint64
+	// Proposer is the address of the account that proposed the block
+	Proposer string
+	// Payout is the proposer reward of the block in microalgos
+	Payout int
+}
+
+// GetProposal fetches a block and returns its proposer and payout.
+// Blocks from before consensus incentives have no proposer.
+func GetProposal(ctx context.Context, client api.ClientWithResponsesInterface, round uint64) (Proposal, api.ResponseInterface, error) {
+	var format api.GetBlockParamsFormat = "json"
+	proposal := Proposal{Round: round}
+	response, err := client.GetBlockWithResponse(ctx, int(round), &api.GetBlockParams{
+		Format: &format,
+	})
+	if err != nil {
+		return proposal, response, err
+	}
+	if response.StatusCode() != 200 {
+		return proposal, response, errors.New(response.Status())
+	}
+
+	if proposer, ok := response.JSON200.Block["prp"].(string); ok {
+		proposal.Proposer = proposer
+	}
+	if payout, ok := response.JSON200.Block["pp"].(float64); ok {
+		proposal.Payout = int(payout)
+	}
+	return proposal, response, nil
+}
+
+// GetOnlineMoney fetches the total online stake of the network in microalgos.
+func GetOnlineMoney(ctx context.Context, client api.ClientWithResponsesInterface) (int, api.ResponseInterface, error) {
+	response, err := client.GetSupplyWithResponse(ctx)
+	if err != nil {
+		return 0, response, err
+	}
+	if response.StatusCode() != 200 {
+		return 0, response, errors.New(response.Status())
+	}
+	return response.JSON200.OnlineMoney, response, nil
+}
+
+// AccountPerformance holds the block proposal statistics of an account.
+type AccountPerformance struct {
+	// Proposals is the number of scanned blocks proposed by the account
+	Proposals int
+	// Expected is the number of proposals expected from the stake share of the account in the scanned rounds
+	Expected float64
+	// Rewards are the proposer payouts of the scanned blocks in microalgos
+	Rewards int
+	// LastProposal is the last round the account proposed a block, zero when it is unknown
+	LastProposal uint64
+}
+
+// Ratio compares the proposals to the expected proposals, 1 is in line with the stake of the account.
+// It is zero until a proposal is expected.
+func (p AccountPerformance) Ratio() float64 {
+	if p.Expected == 0 {
+		return 0
+	}
+	return float64(p.Proposals) / p.Expected
+}
+
+// SinceLastProposal estimates the time since the last proposal from the average round time.
+// It is zero when the account has no known proposal.
+func (p AccountPerformance) SinceLastProposal(lastRound uint64, roundTime time.Duration) time.Duration {
+	if p.LastProposal == 0 || lastRound < p.LastProposal {
+		return 0
+	}
+	return time.Duration(lastRound-p.LastProposal) * roundTime
+}
+
+// Performance tracks the block proposals of the accounts on the node by scanning the blocks of the network.
+type Performance struct {
+	// StartRound is the first scanned round
+	StartRound uint64
+	// LastRound is the last scanned round
+	LastRound uint64
+	// Rounds is the number of scanned blocks
+	Rounds int
+	// OnlineMoney is the total online stake of the network in microalgos
+	OnlineMoney int
+	// Accounts are the statistics by address
+	Accounts map[string]AccountPerformance
+
+	// supplyRound is the round the online stake was fetched at
+	supplyRound uint64
+}
+
+// Observe adds a block to the statistics of the accounts.
+// Online accounts expect to propose their share of the online stake of every block.
+func (p *Performance) Observe(proposal Proposal, accounts map[string]Account) {
+	if p.Accounts == nil {
+		p.Accounts = make(map[string]AccountPerformance)
+	}
+	if p.StartRound == 0 {
+		p.StartRound = proposal.Round
+	}
+	p.LastRound = proposal.Round
+	p.Rounds++
+
+	for address, acct := range accounts {
+		stats := p.Accounts[address]
+		if acct.Status == "Online" && p.OnlineMoney > 0 {
+			stats.Expected += float64(acct.Balance) * 1000000 / float64(p.OnlineMoney)
+		}
+		if proposal.Proposer == address {
+			stats.Proposals++
+			stats.Rewards += proposal.Payout
+			stats.LastProposal = proposal.Round
+		}
+		// The node knows proposals from before the scan
+		if uint64(acct.LastProposed) > stats.LastProposal {
+			stats.LastProposal = uint64(acct.LastProposed)
+		}
+		p.Accounts[address] = stats
+	}
+}
+
+// Scan adds the blocks from the first to the last round, refreshing the online stake when it is outdated.
+func (p *Performance) Scan(ctx context.Context, client api.ClientWithResponsesInterface, first uint64, last uint64, accounts map[string]Account) error {
+	for round := first; round <= last; round++ {
+		if p.OnlineMoney == 0 || round >= p.supplyRound+supplyInterval {
+			onlineMoney, _, err := GetOnlineMoney(ctx, client)
+			if err != nil {
+				return err
+			}
+			p.OnlineMoney = onlineMoney
+			p.supplyRound = round
+		}
+		proposal, _, err := GetProposal(ctx, client, round)
+		if err != nil {
+			return err
+		}
+		p.Observe(proposal, accounts)
+	}
+	return nil
+}
+
+// Update scans the rounds since the last scanned round up to the round.
+// The first update and long gaps only scan the round itself.
+func (p *Performance) Update(ctx context.Context, client api.ClientWithResponsesInterface, round uint64, accounts map[string]Account) error {
+	if round == 0 || round <= p.LastRound {
+		return nil
+	}
+	first := p.LastRound + 1
+	if p.LastRound == 0 || round-p.LastRound > maxScanRounds {
+		first = round
+	}
+	return p.Scan(ctx, client, first, round, accounts)
+}
@@ -0,0 +1,64 @@
+package algod
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Performance(t *testing.T) {
+	ctx := context.Background()
+	client := test.GetClient(false)
+	accounts := map[string]Account{
+		// A tenth of the online stake of the mock client
+		"ABC":     {Address: "ABC", Status: "Online", Balance: 1000000},
+		"OFFLINE": {Address: "OFFLINE", Status: "Offline", Balance: 1000000, LastProposed: 3},
+	}
+
+	var performance Performance
+	err := performance.Scan(ctx, client, 1, 10, accounts)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), performance.StartRound)
+	assert.Equal(t, uint64(10), performance.LastRound)
+	assert.Equal(t, 10, performance.Rounds)
+	assert.Equal(t, 10000000000000, performance.OnlineMoney)
+
+	// The mock client proposes every even round
+	abc := performance.Accounts["ABC"]
+	assert.Equal(t, 5, abc.Proposals)
+	assert.InDelta(t, 1.0, abc.Expected, 0.0001)
+	assert.InDelta(t, 5.0, abc.Ratio(), 0.0001)
+	assert.Equal(t, 5000000, abc.Rewards)
+	assert.Equal(t, uint64(10), abc.LastProposal)
+	assert.Equal(t, 6*time.Second, abc.SinceLastProposal(12, 3*time.Second))
+
+	// Offline accounts expect nothing and keep the proposal known by the node
+	offline := performance.Accounts["OFFLINE"]
+	assert.Equal(t, 0, offline.Proposals)
+	assert.Equal(t, 0.0, offline.Expected)
+	assert.Equal(t, 0.0, offline.Ratio())
+	assert.Equal(t, uint64(3), offline.LastProposal)
+
+	// Rounds are only scanned once
+	assert.Nil(t, performance.Update(ctx, client, 10, accounts))
+	assert.Equal(t, 10, performance.Rounds)
+	assert.Nil(t, performance.Update(ctx, client, 12, accounts))
+	assert.Equal(t, 12, performance.Rounds)
+	assert.Equal(t, 6, performance.Accounts["ABC"].Proposals)
+
+	// Long gaps only scan the latest round
+	assert.Nil(t, performance.Update(ctx, client, 100, accounts))
+	assert.Equal(t, 13, performance.Rounds)
+	assert.Equal(t, uint64(100), performance.LastRound)
+
+	// Unknown proposals have no duration
+	assert.Equal(t, time.Duration(0), AccountPerformance{}.SinceLastProposal(100, time.Second))
+
+	err = new(Performance).Update(ctx, test.GetClient(true), 10, accounts)
+	assert.NotNil(t, err)
+	err = new(Performance).Update(ctx, test.NewClient(false, true), 10, accounts)
+	assert.NotNil(t, err)
+}
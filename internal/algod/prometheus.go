@@ -0,0 +1,316 @@
+package algod
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MetricType is the type of a metric family declared by a # TYPE line.
+type MetricType string
+
+const (
+	CounterMetric   MetricType = "counter"
+	GaugeMetric     MetricType = "gauge"
+	HistogramMetric MetricType = "histogram"
+	SummaryMetric   MetricType = "summary"
+	UntypedMetric   MetricType = "untyped"
+)
+
+// Sample is a single value of a metric, like `algod_network_peers{type="in"} 4`.
+type Sample struct {
+	// Name of the sample, histograms and summaries use the _bucket, _sum and _count suffixes
+	Name string
+	// Labels of the sample, empty when the sample has none
+	Labels map[string]string
+	// Value of the sample, including NaN and +/-Inf
+	Value float64
+	// Timestamp in milliseconds since the epoch, zero when not set
+	Timestamp int64
+}
+
+// HasLabels checks if the sample has all the labels, a nil map matches any sample.
+func (s Sample) HasLabels(labels map[string]string) bool {
+	for name, value := range labels {
+		if v, ok := s.Labels[name]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+// Metric is a family of samples sharing a name, help text and type.
+type Metric struct {
+	Name    string
+	Help    string
+	Type    MetricType
+	Samples []Sample
+}
+
+// MetricsResponse represents the metrics of the node by family name.
+type MetricsResponse map[string]Metric
+
+// Find returns the samples with the name and labels.
+// Histogram and summary samples are found by their suffixed names.
+func (r MetricsResponse) Find(name string, labels map[string]string) []Sample {
+	family, ok := r[name]
+	if !ok {
+		for _, suffix := range []string{"_bucket", "_sum", "_count"} {
+			if family, ok = r[strings.TrimSuffix(name, suffix)]; ok {
+				break
+			}
+		}
+	}
+
+	var samples []Sample
+	for _, sample := range family.Samples {
+		if sample.Name == name && sample.HasLabels(labels) {
+			samples = append(samples, sample)
+		}
+	}
+	return samples
+}
+
+// Value returns the value of the first sample with the name and labels.
+func (r MetricsResponse) Value(name string, labels map[string]string) (float64, bool) {
+	samples := r.Find(name, labels)
+	if len(samples) == 0 {
+		return 0, false
+	}
+	return samples[0].Value, true
+}
+
+// Sum adds up the samples with the name and labels, like the peers of every type.
+func (r MetricsResponse) Sum(name string, labels map[string]string) float64 {
+	var sum float64
+	for _, sample := range r.Find(name, labels) {
+		sum += sample.Value
+	}
+	return sum
+}
+
+// parseMetricsContent parses the Prometheus text exposition format and returns the metric families.
+// Samples without a # TYPE line are added to an untyped family of the same name.
+func parseMetricsContent(content string) (MetricsResponse, error) {
+	if !strings.HasPrefix(content, "#") {
+		return nil, errors.New("invalid metrics content")
+	}
+
+	result := MetricsResponse{}
+	// The family declared by the last # HELP or # TYPE line
+	current := ""
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "#") {
+			fields := strings.Fields(line)
+			if len(fields) < 3 || (fields[1] != "HELP" && fields[1] != "TYPE") {
+				// Plain comment
+				continue
+			}
+			current = fields[2]
+			family := result[current]
+			family.Name = current
+			if fields[1] == "HELP" {
+				// The help is the rest of the line after the name, the name can appear in "# HELP" itself
+				help := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line[1:]), "HELP"))
+				help = strings.TrimPrefix(help, current)
+				family.Help = unescape(strings.TrimSpace(help), false)
+			} else {
+				if len(fields) != 4 {
+					return nil, fmt.Errorf("line %d: invalid TYPE", i+1)
+				}
+				family.Type = MetricType(fields[3])
+			}
+			result[current] = family
+			continue
+		}
+
+		sample, err := parseSample(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		name := current
+		if !isFamilySample(current, result[current].Type, sample.Name) {
+			name = sample.Name
+		}
+		family := result[name]
+		family.Name = name
+		if family.Type == "" {
+			family.Type = UntypedMetric
+		}
+		family.Samples = append(family.Samples, sample)
+		result[name] = family
+	}
+
+	// Give the user what they asked for
+	return result, nil
+}
+
+// isFamilySample checks if the sample name belongs to the family, including the histogram and summary suffixes.
+func isFamilySample(family string, kind MetricType, name string) bool {
+	if family == "" || !strings.HasPrefix(name, family) {
+		return false
+	}
+	switch strings.TrimPrefix(name, family) {
+	case "":
+		return true
+	case "_sum", "_count":
+		return kind == HistogramMetric || kind == SummaryMetric
+	case "_bucket":
+		return kind == HistogramMetric
+	}
+	return false
+}
+
+// parseSample parses a sample line like `name{label="value"} 1.5e3 1700000000000`.
+func parseSample(line string) (Sample, error) {
+	sample := Sample{}
+	end := strings.IndexAny(line, "{ \t")
+	if end == -1 {
+		return sample, fmt.Errorf("missing value for %s", line)
+	}
+	sample.Name = line[:end]
+	if !isMetricName(sample.Name) {
+		return sample, fmt.Errorf("invalid metric name %q", sample.Name)
+	}
+
+	rest := line[end:]
+	if rest[0] == '{' {
+		labels, n, err := parseLabels(rest)
+		if err != nil {
+			return sample, fmt.Errorf("%s: %w", sample.Name, err)
+		}
+		sample.Labels = labels
+		rest = rest[n:]
+	}
+
+	fields := strings.Fields(rest)
+	if len(fields) == 0 || len(fields) > 2 {
+		return sample, fmt.Errorf("%s: expected a value and an optional timestamp", sample.Name)
+	}
+	value, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return sample, fmt.Errorf("%s: invalid value %q", sample.Name, fields[0])
+	}
+	sample.Value = value
+	if len(fields) == 2 {
+		sample.Timestamp, err = strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return sample, fmt.Errorf("%s: invalid timestamp %q", sample.Name, fields[1])
+		}
+	}
+	return sample, nil
+}
+
+// parseLabels parses a label set starting with `{` and returns the labels and the length of the set.
+func parseLabels(s string) (map[string]string, int, error) {
+	labels := make(map[string]string)
+	i := 1
+	skipSpace := func() {
+		for i < len(s) && (s[i] == ' ' || s[i] == '\t') {
+			i++
+		}
+	}
+	for {
+		skipSpace()
+		if i >= len(s) {
+			return nil, i, errors.New("unterminated label set")
+		}
+		if s[i] == '}' {
+			return labels, i + 1, nil
+		}
+
+		start := i
+		for i < len(s) && isNameChar(s[i], i == start, false) {
+			i++
+		}
+		name := s[start:i]
+		if name == "" {
+			return nil, i, fmt.Errorf("invalid label name at %q", s[start:])
+		}
+		skipSpace()
+		if i >= len(s) || s[i] != '=' {
+			return nil, i, fmt.Errorf("missing = after label %s", name)
+		}
+		i++
+		skipSpace()
+		if i >= len(s) || s[i] != '"' {
+			return nil, i, fmt.Errorf("missing quote for label %s", name)
+		}
+		i++
+
+		// Find the closing quote, skipping escaped characters
+		start = i
+		for i < len(s) && s[i] != '"' {
+			if s[i] == '\\' {
+				i++
+			}
+			i++
+		}
+		if i >= len(s) {
+			return nil, i, fmt.Errorf("unterminated value for label %s", name)
+		}
+		labels[name] = unescape(s[start:i], true)
+		i++
+
+		skipSpace()
+		if i < len(s) && s[i] == ',' {
+			i++
+		}
+	}
+}
+
+// unescape replaces the \\ and \n escapes of help texts, and \" of label values.
+func unescape(s string, quotes bool) string {
+	if !strings.Contains(s, "\\") {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			switch next := s[i+1]; {
+			case next == 'n':
+				b.WriteByte('\n')
+				i++
+				continue
+			case next == '\\', next == '"' && quotes:
+				b.WriteByte(next)
+				i++
+				continue
+			}
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// isMetricName checks the name matches [a-zA-Z_:][a-zA-Z0-9_:]*.
+func isMetricName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		if !isNameChar(name[i], i == 0, true) {
+			return false
+		}
+	}
+	return true
+}
+
+// isNameChar checks if the character is valid in a metric or label name, colons are only valid in metric names.
+func isNameChar(c byte, first bool, colon bool) bool {
+	switch {
+	case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_':
+		return true
+	case c == ':':
+		return colon
+	case c >= '0' && c <= '9':
+		return !first
+	}
+	return false
+}
@@ -0,0 +1,208 @@
+package algod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod/participation"
+)
+
+const (
+	// DefaultRenewBefore is the number of rounds before expiry to renew a key, roughly one week.
+	DefaultRenewBefore = 216_000
+
+	// DefaultRenewDuration is the number of rounds a renewed key is valid for, roughly three months.
+	DefaultRenewDuration = 3_000_000
+
+	// RegistrationDelay is the number of rounds before a registered key is used,
+	// votes use the balance record from 320 rounds earlier.
+	RegistrationDelay = 320
+)
+
+// RenewalPolicy decides when an online account needs a new participation key and how long the new key is valid.
+type RenewalPolicy struct {
+	// Before is the number of rounds before the registered key expires to start the renewal.
+	Before int
+	// Duration is the number of rounds the new key is valid for.
+	Duration int
+	// Dilution of the new key, the node default is used when nil.
+	Dilution *int
+}
+
+// NeedsRenewal checks if the account is online with a registered key expiring within the policy window.
+func (p RenewalPolicy) NeedsRenewal(acct Account, lastRound uint64) bool {
+	if acct.Status != "Online" || acct.Participation == nil {
+		return false
+	}
+	return acct.Participation.VoteLastValid-int(lastRound) <= p.Before
+}
+
+// Params returns the key generation parameters for a key starting at the round.
+func (p RenewalPolicy) Params(lastRound uint64) api.GenerateParticipationKeysParams {
+	return api.GenerateParticipationKeysParams{
+		Dilution: p.Dilution,
+		First:    int(lastRound),
+		Last:     int(lastRound) + p.Duration,
+	}
+}
+
+// FindRenewalKey returns the key on the node that is valid the longest past the account's registered key.
+// It returns nil when there is no such key.
+func FindRenewalKey(keys participation.List, acct Account) *api.ParticipationKey {
+	lastValid := 0
+	if acct.Participation != nil {
+		lastValid = acct.Participation.VoteLastValid
+	}
+	var next *api.ParticipationKey
+	for i, key := range keys {
+		if key.Address != acct.Address || key.Key.VoteLastValid <= lastValid {
+			continue
+		}
+		if next == nil || key.Key.VoteLastValid > next.Key.VoteLastValid {
+			next = &keys[i]
+		}
+	}
+	return next
+}
+
+// FindRegisteredKey returns the key on the node that is registered on-chain for the account, or nil.
+func FindRegisteredKey(keys participation.List, acct Account) *api.ParticipationKey {
+	if acct.Participation == nil {
+		return nil
+	}
+	for i, key := range keys {
+		if key.Address == acct.Address && participation.IsActive(key, *acct.Participation) {
+			return &keys[i]
+		}
+	}
+	return nil
+}
+
+// Renewal tracks the replacement of an account's participation key.
+type Renewal struct {
+	// Address of the account being renewed
+	Address string
+	// Previous is the key registered when the renewal started, it is deleted once Next is active.
+	// It is nil when the registered key is not on this node.
+	Previous *api.ParticipationKey
+	// Next is the key that has to be registered with an online keyreg transaction
+	Next *api.ParticipationKey
+	// RegisteredRound is the round at which Next was first seen registered, zero until then
+	RegisteredRound uint64
+}
+
+// IsRegistered checks if the next key is the one registered on-chain for the account.
+func (r Renewal) IsRegistered(acct Account) bool {
+	return r.Next != nil && acct.Participation != nil && participation.IsActive(*r.Next, *acct.Participation)
+}
+
+// IsActive checks if the next key is used to vote, RegistrationDelay rounds after its registration
+// or as soon as it has voted.
+func (r Renewal) IsActive(keys participation.List, lastRound uint64) bool {
+	if r.RegisteredRound == 0 {
+		return false
+	}
+	if lastRound >= r.RegisteredRound+RegistrationDelay {
+		return true
+	}
+	for _, key := range keys {
+		if key.Id == r.Next.Id {
+			return key.LastVote != nil
+		}
+	}
+	return false
+}
+
+// Retire deletes the previous key from the node.
+func (r Renewal) Retire(ctx context.Context, client api.ClientWithResponsesInterface) error {
+	if r.Previous == nil {
+		return nil
+	}
+	return participation.Delete(ctx, client, r.Previous.Id)
+}
+
+// Renewer applies a RenewalPolicy to the accounts of a StateModel and tracks the renewals in progress.
+type Renewer struct {
+	Policy RenewalPolicy
+	// Pending renewals waiting for the next key to be registered, by address
+	Pending map[string]Renewal
+}
+
+// Start begins a renewal for each address that needs one, generating a key unless a suitable one is already on the node.
+// All the accounts of the state are checked when no addresses are given, force skips the policy window check.
+func (r *Renewer) Start(ctx context.Context, state *StateModel, addresses []string, force bool) ([]Renewal, error) {
+	if r.Pending == nil {
+		r.Pending = make(map[string]Renewal)
+	}
+	if len(addresses) == 0 {
+		for address := range state.Accounts {
+			addresses = append(addresses, address)
+		}
+	}
+
+	var started []Renewal
+	var errs []error
+	for _, address := range addresses {
+		if _, ok := r.Pending[address]; ok {
+			continue
+		}
+		acct, ok := state.Accounts[address]
+		if !ok {
+			errs = append(errs, fmt.Errorf("no participation keys found for %s", address))
+			continue
+		}
+		if !force && !r.Policy.NeedsRenewal(acct, state.Status.LastRound) {
+			continue
+		}
+
+		renewal := Renewal{
+			Address:  address,
+			Previous: FindRegisteredKey(state.ParticipationKeys, acct),
+			Next:     FindRenewalKey(state.ParticipationKeys, acct),
+		}
+		// Reuse a key generated by a previous run, otherwise generate one
+		if renewal.Next == nil || force {
+			params := r.Policy.Params(state.Status.LastRound)
+			key, err := participation.GenerateKeys(ctx, state.Client, address, &params)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to generate a key for %s: %w", address, err))
+				continue
+			}
+			renewal.Next = key
+		}
+		r.Pending[address] = renewal
+		started = append(started, renewal)
+	}
+	return started, errors.Join(errs...)
+}
+
+// Complete retires the previous key of every pending renewal once the next key is registered on-chain and active.
+// It returns the completed renewals.
+func (r *Renewer) Complete(ctx context.Context, state *StateModel) ([]Renewal, error) {
+	var completed []Renewal
+	var errs []error
+	for address, renewal := range r.Pending {
+		if !renewal.IsRegistered(state.Accounts[address]) {
+			renewal.RegisteredRound = 0
+			r.Pending[address] = renewal
+			continue
+		}
+		if renewal.RegisteredRound == 0 {
+			renewal.RegisteredRound = state.Status.LastRound
+			r.Pending[address] = renewal
+		}
+		// The previous key keeps voting until the next one is used
+		if !renewal.IsActive(state.ParticipationKeys, state.Status.LastRound) {
+			continue
+		}
+		if err := renewal.Retire(ctx, state.Client); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		delete(r.Pending, address)
+		completed = append(completed, renewal)
+	}
+	return completed, errors.Join(errs...)
+}
@@ -0,0 +1,143 @@
+package algod
+
+import (
+	"context"
+	"testing"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/test"
+	"github.com/algorandfoundation/nodekit/internal/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func getRenewalState() *StateModel {
+	registered := mock.Keys[0].Key
+	registered.VoteLastValid = 1000
+	previous := mock.Keys[0]
+	previous.Id = "previous"
+	previous.Key = registered
+	return &StateModel{
+		Status: Status{LastRound: 900},
+		Accounts: map[string]Account{
+			"ABC": {
+				Address:       "ABC",
+				Status:        "Online",
+				Participation: &registered,
+			},
+		},
+		ParticipationKeys: []api.ParticipationKey{previous, mock.Keys[0]},
+		Client:            test.GetClient(false),
+	}
+}
+
+func Test_RenewalPolicy(t *testing.T) {
+	state := getRenewalState()
+	acct := state.Accounts["ABC"]
+	assert.True(t, RenewalPolicy{Before: 100}.NeedsRenewal(acct, 900))
+	assert.False(t, RenewalPolicy{Before: 99}.NeedsRenewal(acct, 900))
+
+	acct.Status = "Offline"
+	assert.False(t, RenewalPolicy{Before: 100}.NeedsRenewal(acct, 900))
+
+	params := RenewalPolicy{Duration: 50}.Params(900)
+	assert.Equal(t, 900, params.First)
+	assert.Equal(t, 950, params.Last)
+}
+
+func Test_FindKeys(t *testing.T) {
+	state := getRenewalState()
+	acct := state.Accounts["ABC"]
+
+	registered := FindRegisteredKey(state.ParticipationKeys, acct)
+	assert.NotNil(t, registered)
+	assert.Equal(t, "previous", registered.Id)
+
+	next := FindRenewalKey(state.ParticipationKeys, acct)
+	assert.NotNil(t, next)
+	assert.Equal(t, "123", next.Id)
+
+	acct.Address = "EXPIRED"
+	assert.Nil(t, FindRenewalKey(state.ParticipationKeys, acct))
+}
+
+func Test_Renewer(t *testing.T) {
+	ctx := context.Background()
+	state := getRenewalState()
+	renewer := Renewer{Policy: RenewalPolicy{Before: 50}}
+
+	// Not within the renewal window
+	started, err := renewer.Start(ctx, state, nil, false)
+	assert.Nil(t, err)
+	assert.Empty(t, started)
+
+	// The key already on the node is reused
+	renewer.Policy.Before = 200
+	started, err = renewer.Start(ctx, state, nil, false)
+	assert.Nil(t, err)
+	assert.Len(t, started, 1)
+	assert.Equal(t, "previous", started[0].Previous.Id)
+	assert.Equal(t, "123", started[0].Next.Id)
+
+	// Nothing completes until the next key is registered
+	completed, err := renewer.Complete(ctx, state)
+	assert.Nil(t, err)
+	assert.Empty(t, completed)
+
+	// The previous key is kept until the next key is used to vote
+	acct := state.Accounts["ABC"]
+	acct.Participation = &mock.Keys[0].Key
+	state.Accounts["ABC"] = acct
+	completed, err = renewer.Complete(ctx, state)
+	assert.Nil(t, err)
+	assert.Empty(t, completed)
+	assert.Equal(t, uint64(900), renewer.Pending["ABC"].RegisteredRound)
+
+	state.Status.LastRound = 900 + RegistrationDelay - 1
+	completed, err = renewer.Complete(ctx, state)
+	assert.Nil(t, err)
+	assert.Empty(t, completed)
+
+	state.Status.LastRound = 900 + RegistrationDelay
+	completed, err = renewer.Complete(ctx, state)
+	assert.Nil(t, err)
+	assert.Len(t, completed, 1)
+	assert.Empty(t, renewer.Pending)
+
+	_, err = renewer.Start(ctx, state, []string{"UNKNOWN"}, false)
+	assert.NotNil(t, err)
+}
+
+func Test_RenewerLastVote(t *testing.T) {
+	ctx := context.Background()
+	state := getRenewalState()
+	renewer := Renewer{Policy: RenewalPolicy{Before: 200}}
+	_, err := renewer.Start(ctx, state, nil, false)
+	assert.Nil(t, err)
+
+	acct := state.Accounts["ABC"]
+	acct.Participation = &mock.Keys[0].Key
+	state.Accounts["ABC"] = acct
+	completed, err := renewer.Complete(ctx, state)
+	assert.Nil(t, err)
+	assert.Empty(t, completed)
+
+	// The next key voted before the end of the delay
+	lastVote := 910
+	state.ParticipationKeys[1].LastVote = &lastVote
+	state.Status.LastRound = 910
+	completed, err = renewer.Complete(ctx, state)
+	assert.Nil(t, err)
+	assert.Len(t, completed, 1)
+}
+
+func Test_RenewerErrors(t *testing.T) {
+	ctx := context.Background()
+	state := getRenewalState()
+	renewer := Renewer{Policy: RenewalPolicy{Before: 200}}
+
+	// An unknown address does not stop the renewal of the others
+	started, err := renewer.Start(ctx, state, []string{"UNKNOWN", "ABC"}, false)
+	assert.ErrorContains(t, err, "no participation keys found for UNKNOWN")
+	assert.Len(t, started, 1)
+	assert.Equal(t, "ABC", started[0].Address)
+}
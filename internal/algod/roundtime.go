@@ -0,0 +1,177 @@
+package algod
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/algod/utils"
+	"github.com/algorandfoundation/nodekit/internal/system"
+)
+
+// TargetRoundTime is the round time the consensus protocol aims for,
+// it is the estimate before the node measured any round.
+const TargetRoundTime = time.Millisecond * 2800
+
+// targetWeight is how many rounds of measurements the TargetRoundTime is worth in an estimate.
+const targetWeight = 100
+
+// maxAverageWeight caps the rounds of the long-term average in an estimate and in its own updates,
+// so it still follows the network when the round time changes.
+const maxAverageWeight = 10000
+
+// targetSpread is the confidence range around the TargetRoundTime when nothing was measured.
+const targetSpread = 0.1
+
+// minSpread is the smallest confidence range around an estimate.
+const minSpread = 0.02
+
+// RoundTimeFilename is the name of the long-term round time averages in the NodeKit directory of the home directory.
+const RoundTimeFilename = "roundtime.json"
+
+// RoundTimeAverage is the long-term average round time of a network, kept between runs.
+type RoundTimeAverage struct {
+	RoundTime time.Duration `json:"roundTime"`
+	// Rounds is how many rounds the average was measured over
+	Rounds uint64 `json:"rounds"`
+	// LastRound is the round of the last measurement
+	LastRound uint64 `json:"lastRound"`
+}
+
+// Add includes the average round time measured over the window of rounds before the round.
+// Only the rounds since the previous measurement count, at most the window, so a gap like
+// after a restart does not outweigh the history. Rounds that were already included are ignored.
+func (a RoundTimeAverage) Add(roundTime time.Duration, window int, round uint64) RoundTimeAverage {
+	if roundTime <= 0 || window <= 0 || round <= a.LastRound {
+		return a
+	}
+	rounds := min(round-a.LastRound, uint64(window))
+	// The first measurement only sets the starting round
+	if a.LastRound == 0 {
+		rounds = 0
+	}
+	weight := min(a.Rounds, maxAverageWeight)
+	if weight+rounds > 0 {
+		a.RoundTime = time.Duration((float64(a.RoundTime)*float64(weight) + float64(roundTime)*float64(rounds)) / float64(weight+rounds))
+	}
+	a.Rounds += rounds
+	a.LastRound = round
+	return a
+}
+
+// RoundTimeAverages are the long-term averages by network.
+type RoundTimeAverages map[string]RoundTimeAverage
+
+// DefaultRoundTimePath returns the path of the long-term averages in the home directory.
+func DefaultRoundTimePath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".nodekit", RoundTimeFilename), nil
+}
+
+// LoadRoundTimeAverages reads the long-term averages, a missing file has no averages.
+func LoadRoundTimeAverages(path string) (RoundTimeAverages, error) {
+	averages := make(RoundTimeAverages)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return averages, nil
+	}
+	if err != nil {
+		return averages, err
+	}
+	err = json.Unmarshal(data, &averages)
+	if err != nil {
+		return make(RoundTimeAverages), fmt.Errorf("invalid round times %s: %w", path, err)
+	}
+	return averages, nil
+}
+
+// SaveRoundTimeAverages writes the long-term averages, creating the directory when missing.
+func SaveRoundTimeAverages(path string, averages RoundTimeAverages) error {
+	err := os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(averages, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// RoundTimeEstimate is the expected duration of the next rounds with a confidence range.
+type RoundTimeEstimate struct {
+	RoundTime time.Duration
+	// Min and Max are the bounds of the confidence range
+	Min time.Duration
+	Max time.Duration
+}
+
+// EstimateRoundTime combines the round time measured over the recent window,
+// the long-term average and the TargetRoundTime, weighted by the rounds they stand for.
+// The confidence range spans the measurements, so it narrows as they agree.
+func EstimateRoundTime(recent time.Duration, window int, average RoundTimeAverage) RoundTimeEstimate {
+	total := float64(TargetRoundTime) * targetWeight
+	weight := float64(targetWeight)
+	var measured []time.Duration
+	if recent > 0 && window > 0 {
+		total += float64(recent) * float64(window)
+		weight += float64(window)
+		measured = append(measured, recent)
+	}
+	if average.RoundTime > 0 && average.Rounds > 0 {
+		rounds := float64(min(average.Rounds, maxAverageWeight))
+		total += float64(average.RoundTime) * rounds
+		weight += rounds
+		measured = append(measured, average.RoundTime)
+	}
+
+	estimate := time.Duration(total / weight)
+	if len(measured) == 0 {
+		return RoundTimeEstimate{
+			RoundTime: estimate,
+			Min:       time.Duration(float64(estimate) * (1 - targetSpread)),
+			Max:       time.Duration(float64(estimate) * (1 + targetSpread)),
+		}
+	}
+	result := RoundTimeEstimate{
+		RoundTime: estimate,
+		Min:       time.Duration(float64(estimate) * (1 - minSpread)),
+		Max:       time.Duration(float64(estimate) * (1 + minSpread)),
+	}
+	for _, roundTime := range measured {
+		result.Min = min(result.Min, roundTime)
+		result.Max = max(result.Max, roundTime)
+	}
+	return result
+}
+
+// RoundTime estimates the round time of the node from its metrics and the long-term average.
+func (s *StateModel) RoundTime() RoundTimeEstimate {
+	return EstimateRoundTime(s.Metrics.RoundTime, s.Metrics.Window, s.RoundTimeAverage)
+}
+
+// Expiry is when a participation key is expected to expire, with the range of the round time estimate.
+type Expiry struct {
+	Time     time.Time
+	Earliest time.Time
+	Latest   time.Time
+}
+
+// Expires estimates when the last valid round is reached, it returns false while the last round is unknown.
+func (e RoundTimeEstimate) Expires(t system.Time, lastRound int, voteLastValid int) (Expiry, bool) {
+	expires := utils.GetExpiresTime(t, lastRound, e.RoundTime, voteLastValid)
+	if expires == nil {
+		return Expiry{}, false
+	}
+	return Expiry{
+		Time:     *expires,
+		Earliest: *utils.GetExpiresTime(t, lastRound, e.Min, voteLastValid),
+		Latest:   *utils.GetExpiresTime(t, lastRound, e.Max, voteLastValid),
+	}, true
+}
@@ -0,0 +1,90 @@
+package algod
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RoundTimeAverage(t *testing.T) {
+	var average RoundTimeAverage
+	// The first measurement only sets the starting round
+	average = average.Add(3*time.Second, 100, 100)
+	assert.Equal(t, RoundTimeAverage{LastRound: 100}, average)
+
+	average = average.Add(3*time.Second, 100, 110)
+	assert.Equal(t, 3*time.Second, average.RoundTime)
+	assert.Equal(t, uint64(10), average.Rounds)
+
+	average = average.Add(2*time.Second, 100, 120)
+	assert.Equal(t, 2500*time.Millisecond, average.RoundTime)
+	assert.Equal(t, uint64(20), average.Rounds)
+
+	// Older rounds and missing measurements are ignored
+	assert.Equal(t, average, average.Add(time.Second, 100, 110))
+	assert.Equal(t, average, average.Add(0, 100, 130))
+
+	// A restart after a long gap only counts the rounds of the metrics window
+	average = RoundTimeAverage{RoundTime: 3 * time.Second, Rounds: maxAverageWeight, LastRound: 1000}
+	average = average.Add(2*time.Second, 100, 31000)
+	assert.Equal(t, uint64(maxAverageWeight+100), average.Rounds)
+	assert.Equal(t, uint64(31000), average.LastRound)
+	assert.InDelta(t, float64(3*time.Second), float64(average.RoundTime), float64(10*time.Millisecond))
+}
+
+func Test_EstimateRoundTime(t *testing.T) {
+	// Only the protocol target is known
+	estimate := EstimateRoundTime(0, 100, RoundTimeAverage{})
+	assert.Equal(t, TargetRoundTime, estimate.RoundTime)
+	assert.Equal(t, 2520*time.Millisecond, estimate.Min)
+	assert.Equal(t, 3080*time.Millisecond, estimate.Max)
+
+	// The recent window weighs as much as the target
+	estimate = EstimateRoundTime(2*time.Second, 100, RoundTimeAverage{})
+	assert.Equal(t, 2400*time.Millisecond, estimate.RoundTime)
+	assert.Equal(t, 2*time.Second, estimate.Min)
+	assert.Equal(t, 2448*time.Millisecond, estimate.Max)
+
+	// A long-term average outweighs the others
+	estimate = EstimateRoundTime(2*time.Second, 100, RoundTimeAverage{RoundTime: 3 * time.Second, Rounds: 1000000})
+	assert.Equal(t, 2988*time.Millisecond, estimate.RoundTime.Round(time.Millisecond))
+	assert.Equal(t, 2*time.Second, estimate.Min)
+	assert.Equal(t, 3048*time.Millisecond, estimate.Max.Round(time.Millisecond))
+
+	expiry, ok := estimate.Expires(new(mock.Clock), 100, 200)
+	assert.True(t, ok)
+	assert.Equal(t, time.Time{}.Add(100*estimate.RoundTime), expiry.Time)
+	assert.Equal(t, time.Time{}.Add(200*time.Second), expiry.Earliest)
+	assert.Equal(t, time.Time{}.Add(100*estimate.Max), expiry.Latest)
+	_, ok = estimate.Expires(new(mock.Clock), 0, 200)
+	assert.False(t, ok)
+
+	acct := Account{Address: "ABC", Status: "Online", Participation: mock.ABCAccount.Participation}
+	acct = acct.UpdateExpiredTime(new(mock.Clock), nil, 100, estimate)
+	assert.NotNil(t, acct.ExpiresEarliest)
+	assert.True(t, acct.ExpiresEarliest.Before(*acct.Expires))
+	assert.True(t, acct.ExpiresLatest.After(*acct.Expires))
+}
+
+func Test_RoundTimeAverages(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nodekit", RoundTimeFilename)
+
+	// A missing file has no averages
+	averages, err := LoadRoundTimeAverages(path)
+	assert.Nil(t, err)
+	assert.Empty(t, averages)
+
+	averages["mainnet-v1.0"] = RoundTimeAverage{RoundTime: 2800 * time.Millisecond, Rounds: 500, LastRound: 1000}
+	assert.Nil(t, SaveRoundTimeAverages(path, averages))
+	loaded, err := LoadRoundTimeAverages(path)
+	assert.Nil(t, err)
+	assert.Equal(t, averages, loaded)
+
+	assert.Nil(t, os.WriteFile(path, []byte("{"), 0600))
+	_, err = LoadRoundTimeAverages(path)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,153 @@
+package algod
+
+import (
+	"context"
+	"runtime"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/algod/linux"
+	"github.com/algorandfoundation/nodekit/internal/algod/mac"
+	"github.com/algorandfoundation/nodekit/internal/algod/utils"
+	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/algorandfoundation/nodekit/internal/system/proc"
+	"github.com/algorandfoundation/nodekit/internal/system/systemd"
+)
+
+const (
+	// SystemdManager is the service manager of algod installed as a systemd unit.
+	SystemdManager = "systemd"
+	// SystemdUserManager is the service manager of algod installed as a unit of the systemd instance of the user.
+	SystemdUserManager = "systemd-user"
+	// LaunchdManager is the service manager of algod installed as a launchd daemon.
+	LaunchdManager = "launchd"
+	// FallbackManager is used for an algod started without a service manager, like by the fallback installation.
+	FallbackManager = "fallback"
+)
+
+// serviceInterval is how often the watcher refreshes the service status.
+const serviceInterval = time.Second * 10
+
+// crashLoopUptime is the uptime under which a restarted service is considered crash-looping.
+const crashLoopUptime = time.Minute
+
+// ServiceStatus describes how algod is managed on the host and the resources used by its process.
+type ServiceStatus struct {
+	// Manager is SystemdManager, SystemdUserManager, LaunchdManager or FallbackManager
+	Manager string `json:"manager" yaml:"manager"`
+	// Unit is the name of the service, empty for the FallbackManager
+	Unit string `json:"unit,omitempty" yaml:"unit,omitempty"`
+	// ActiveState and SubState are the state of a systemd unit, like active and running
+	ActiveState string `json:"activeState,omitempty" yaml:"activeState,omitempty"`
+	SubState    string `json:"subState,omitempty" yaml:"subState,omitempty"`
+	// Linger is whether a user service keeps running after the user logs out
+	Linger bool `json:"linger,omitempty" yaml:"linger,omitempty"`
+
+	Running bool `json:"running" yaml:"running"`
+	PID     int  `json:"pid,omitempty" yaml:"pid,omitempty"`
+	// StartedAt is when the service became active, or when the process started without a service manager
+	StartedAt *time.Time `json:"startedAt,omitempty" yaml:"startedAt,omitempty"`
+	// Restarts is how many times systemd restarted the service automatically
+	Restarts uint32 `json:"restarts" yaml:"restarts"`
+	// Result and ExitStatus describe the last exit of the main process
+	Result     string `json:"result,omitempty" yaml:"result,omitempty"`
+	ExitStatus int32  `json:"exitStatus" yaml:"exitStatus"`
+
+	// RSS is the resident memory of the process in bytes
+	RSS uint64 `json:"rssBytes" yaml:"rssBytes"`
+	// CPU is the CPU usage as a percentage of one core, averaged since the process started
+	CPU float64 `json:"cpuPercent" yaml:"cpuPercent"`
+	// OpenFiles is the number of open file descriptors, -1 when they can not be read
+	OpenFiles int `json:"openFiles" yaml:"openFiles"`
+	// Listening are the TCP addresses of the process, nil when they can not be read
+	Listening []string `json:"listening" yaml:"listening"`
+}
+
+// Uptime returns how long the service has been running.
+func (s ServiceStatus) Uptime(now time.Time) time.Duration {
+	if !s.Running || s.StartedAt == nil {
+		return 0
+	}
+	return now.Sub(*s.StartedAt)
+}
+
+// IsCrashLooping reports whether the service is waiting to be restarted
+// or was restarted recently, a node can be running but crash-looping.
+func (s ServiceStatus) IsCrashLooping(now time.Time) bool {
+	if s.SubState == "auto-restart" {
+		return true
+	}
+	return s.Restarts > 0 && s.Running && s.Uptime(now) < crashLoopUptime
+}
+
+// setUnit applies the state of the systemd unit.
+func (s *ServiceStatus) setUnit(unit systemd.UnitStatus) {
+	s.Manager = SystemdManager
+	s.Unit = unit.Name
+	s.ActiveState = unit.ActiveState
+	s.SubState = unit.SubState
+	s.Running = unit.IsActive()
+	s.PID = int(unit.MainPID)
+	s.Restarts = unit.Restarts
+	s.Result = unit.Result
+	s.ExitStatus = unit.ExitStatus
+	if !unit.ActiveSince.IsZero() {
+		s.StartedAt = &unit.ActiveSince
+	}
+}
+
+// setStats applies the resource usage of the process.
+func (s *ServiceStatus) setStats(stats proc.Stats, now time.Time) {
+	s.RSS = stats.RSS
+	s.CPU = stats.CPU(now)
+	s.OpenFiles = stats.OpenFiles
+	s.Listening = stats.Listening
+	if s.StartedAt == nil {
+		s.StartedAt = &stats.StartedAt
+	}
+}
+
+// GetServiceStatus returns how algod is managed and the resources used by its process.
+// The resources are read from /proc, they are only available on Linux.
+func GetServiceStatus(t system.Time, dataDir string) ServiceStatus {
+	status := ServiceStatus{Manager: FallbackManager, OpenFiles: -1}
+	switch runtime.GOOS {
+	case "linux":
+		if unit, err := linux.Status(); err == nil && unit.LoadState == "loaded" {
+			status.setUnit(unit)
+			if linux.IsUserService() {
+				status.Manager = SystemdUserManager
+				status.Linger = linux.IsLingering()
+			}
+		}
+	case "darwin":
+		if mac.IsService() {
+			status.Manager = LaunchdManager
+			status.Unit = "com.algorand.algod"
+		}
+	}
+
+	// Without a running unit, the data directory knows the process
+	if status.PID == 0 && IsRunning(dataDir) {
+		resolvedDir, _ := GetDataDir(dataDir)
+		status.PID, _ = utils.GetPidFromDataDir(resolvedDir)
+		status.Running = status.PID != 0
+	}
+	if status.PID != 0 && runtime.GOOS == "linux" {
+		if stats, err := proc.Read(proc.Root, status.PID); err == nil {
+			status.setStats(stats, t.Now())
+		}
+	}
+	return status
+}
+
+// updateService refreshes the service status of a local node and publishes a ServiceUpdatedEvent,
+// it is skipped for remote nodes and until serviceInterval passed since the last refresh.
+func (s *StateModel) updateService(ctx context.Context, t system.Time) {
+	if s.DataDir == "" || (s.Service != nil && t.Now().Sub(s.serviceUpdatedAt) < serviceInterval) {
+		return
+	}
+	status := GetServiceStatus(t, s.DataDir)
+	s.Service = &status
+	s.serviceUpdatedAt = t.Now()
+	s.publish(ctx, ServiceUpdatedEvent, nil)
+}
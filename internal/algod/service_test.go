@@ -0,0 +1,87 @@
+package algod
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/system/systemd"
+	"github.com/algorandfoundation/nodekit/internal/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ServiceStatusUnit(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	status := ServiceStatus{OpenFiles: -1}
+	status.setUnit(systemd.UnitStatus{
+		Name:        "algorand.service",
+		LoadState:   "loaded",
+		ActiveState: "active",
+		SubState:    "running",
+		ActiveSince: now.Add(-30 * time.Second),
+		MainPID:     1234,
+		Restarts:    3,
+		Result:      "success",
+	})
+	assert.Equal(t, SystemdManager, status.Manager)
+	assert.True(t, status.Running)
+	assert.Equal(t, 1234, status.PID)
+	assert.Equal(t, 30*time.Second, status.Uptime(now))
+	// Running, but restarted less than a minute ago
+	assert.True(t, status.IsCrashLooping(now))
+	assert.False(t, status.IsCrashLooping(now.Add(time.Minute)))
+
+	status.setUnit(systemd.UnitStatus{
+		Name:        "algorand.service",
+		LoadState:   "loaded",
+		ActiveState: "activating",
+		SubState:    "auto-restart",
+		Restarts:    4,
+		Result:      "exit-code",
+		ExitStatus:  1,
+	})
+	assert.False(t, status.Running)
+	assert.Equal(t, time.Duration(0), status.Uptime(now))
+	assert.True(t, status.IsCrashLooping(now))
+}
+
+func Test_GetServiceStatus(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("the process resources are only read on Linux")
+	}
+	// A data directory of a node started without a service manager
+	dataDir := t.TempDir()
+	assert.Nil(t, os.WriteFile(filepath.Join(dataDir, "algod.pid"), []byte(strconv.Itoa(os.Getpid())), 0o644))
+
+	status := GetServiceStatus(new(mock.Clock), dataDir)
+	if status.Manager == SystemdManager || status.Manager == SystemdUserManager {
+		t.Skip("an algorand service is installed on this host")
+	}
+	assert.Equal(t, FallbackManager, status.Manager)
+	assert.True(t, status.Running)
+	assert.Equal(t, os.Getpid(), status.PID)
+	assert.NotNil(t, status.StartedAt)
+	assert.Greater(t, status.RSS, uint64(0))
+	assert.Greater(t, status.OpenFiles, 0)
+
+	state := StateModel{DataDir: dataDir}
+	events, unsubscribe := state.Subscribe()
+	defer unsubscribe()
+	state.updateService(context.Background(), new(mock.Clock))
+	assert.Equal(t, ServiceUpdatedEvent, (<-events).Type)
+	assert.Equal(t, os.Getpid(), state.Service.PID)
+
+	// Refreshed at most once per serviceInterval
+	state.Service.PID = 0
+	state.updateService(context.Background(), new(mock.Clock))
+	assert.Equal(t, 0, state.Service.PID)
+
+	// Remote nodes have no service
+	remote := StateModel{}
+	remote.updateService(context.Background(), new(mock.Clock))
+	assert.Nil(t, remote.Service)
+}
@@ -0,0 +1,146 @@
+package signer
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/algorand/go-algorand-sdk/v2/client/kmd"
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/mnemonic"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+// KmdDir is the folder of the kmd instance inside the algod data directory.
+const KmdDir = "kmd-v0.5"
+
+// Signer signs transactions for a single account.
+type Signer interface {
+	// Address of the account the signer signs for
+	Address() string
+	// SignTransaction returns the encoded signed transaction
+	SignTransaction(txn types.Transaction) ([]byte, error)
+}
+
+// MnemonicSigner signs with the private key derived from a 25-word mnemonic.
+type MnemonicSigner struct {
+	account crypto.Account
+}
+
+// NewMnemonicSigner derives the account of the mnemonic.
+func NewMnemonicSigner(phrase string) (*MnemonicSigner, error) {
+	sk, err := mnemonic.ToPrivateKey(strings.Join(strings.Fields(phrase), " "))
+	if err != nil {
+		return nil, fmt.Errorf("invalid mnemonic: %w", err)
+	}
+	account, err := crypto.AccountFromPrivateKey(sk)
+	if err != nil {
+		return nil, err
+	}
+	return &MnemonicSigner{account: account}, nil
+}
+
+// Address of the mnemonic account.
+func (s *MnemonicSigner) Address() string {
+	return s.account.Address.String()
+}
+
+// SignTransaction signs the transaction with the private key.
+func (s *MnemonicSigner) SignTransaction(txn types.Transaction) ([]byte, error) {
+	_, stx, err := crypto.SignTransaction(s.account.PrivateKey, txn)
+	return stx, err
+}
+
+// KmdSigner signs with a key held by a kmd wallet.
+type KmdSigner struct {
+	Client   kmd.Client
+	Handle   string
+	Password string
+	address  string
+}
+
+// GetKmdClient creates a kmd client from the kmd.net and kmd.token files in the data directory.
+// kmd has to be running, for example with `goal kmd start -d <dataDir>`.
+func GetKmdClient(dataDir string) (kmd.Client, error) {
+	dir := filepath.Join(dataDir, KmdDir)
+	netBytes, err := os.ReadFile(filepath.Join(dir, "kmd.net"))
+	if err != nil {
+		return kmd.Client{}, fmt.Errorf("kmd is not running: %w", err)
+	}
+	tokenBytes, err := os.ReadFile(filepath.Join(dir, "kmd.token"))
+	if err != nil {
+		return kmd.Client{}, err
+	}
+	return kmd.MakeClient("http://"+strings.TrimSpace(string(netBytes)), strings.TrimSpace(string(tokenBytes)))
+}
+
+// NewKmdSigner unlocks the wallet and checks that it holds the key of the address.
+func NewKmdSigner(client kmd.Client, wallet string, password string, address string) (*KmdSigner, error) {
+	wallets, err := client.ListWallets()
+	if err != nil {
+		return nil, err
+	}
+	var id string
+	for _, w := range wallets.Wallets {
+		if w.Name == wallet {
+			id = w.ID
+		}
+	}
+	if id == "" {
+		return nil, fmt.Errorf("wallet %s not found", wallet)
+	}
+
+	handle, err := client.InitWalletHandle(id, password)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := client.ListKeys(handle.WalletHandleToken)
+	if err != nil {
+		return nil, err
+	}
+	for _, addr := range keys.Addresses {
+		if addr == address {
+			return &KmdSigner{
+				Client:   client,
+				Handle:   handle.WalletHandleToken,
+				Password: password,
+				address:  address,
+			}, nil
+		}
+	}
+	_, _ = client.ReleaseWalletHandle(handle.WalletHandleToken)
+	return nil, fmt.Errorf("wallet %s does not hold the key for %s", wallet, address)
+}
+
+// Address of the wallet account.
+func (s *KmdSigner) Address() string {
+	return s.address
+}
+
+// SignTransaction asks kmd to sign the transaction.
+func (s *KmdSigner) SignTransaction(txn types.Transaction) ([]byte, error) {
+	res, err := s.Client.SignTransaction(s.Handle, s.Password, txn)
+	if err != nil {
+		return nil, err
+	}
+	return res.SignedTransaction, nil
+}
+
+// Release locks the wallet again.
+func (s *KmdSigner) Release() error {
+	_, err := s.Client.ReleaseWalletHandle(s.Handle)
+	return err
+}
+
+// ErrWrongAccount is returned when a signer is used for a transaction of another account.
+var ErrWrongAccount = errors.New("signer does not match the transaction sender")
+
+// Sign checks the signer matches the sender of the transaction before signing it.
+func Sign(s Signer, txn types.Transaction) ([]byte, error) {
+	if txn.Sender.String() != s.Address() {
+		return nil, ErrWrongAccount
+	}
+	return s.SignTransaction(txn)
+}
@@ -0,0 +1,32 @@
+package signer
+
+import (
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/mnemonic"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_MnemonicSigner(t *testing.T) {
+	account := crypto.GenerateAccount()
+	phrase, err := mnemonic.FromPrivateKey(account.PrivateKey)
+	assert.Nil(t, err)
+
+	// Extra whitespace is ignored
+	s, err := NewMnemonicSigner("  " + phrase + "\n")
+	assert.Nil(t, err)
+	assert.Equal(t, account.Address.String(), s.Address())
+
+	stx, err := Sign(s, types.Transaction{Header: types.Header{Sender: account.Address}})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, stx)
+
+	other := crypto.GenerateAccount()
+	_, err = Sign(s, types.Transaction{Header: types.Header{Sender: other.Address}})
+	assert.ErrorIs(t, err, ErrWrongAccount)
+
+	_, err = NewMnemonicSigner("not a mnemonic")
+	assert.NotNil(t, err)
+}
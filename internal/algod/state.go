@@ -2,6 +2,7 @@ package algod
 
 import (
 	"context"
+	"reflect"
 	"time"
 
 	"github.com/algorandfoundation/nodekit/api"
@@ -30,6 +31,18 @@ type StateModel struct {
 	// This map is derived from the list of the type api.ParticipationKey
 	Accounts map[string]Account
 
+	// WatchAccounts are addresses tracked alongside the Accounts of the participation keys,
+	// for accounts with keys on other nodes.
+	WatchAccounts []string
+
+	// RoundTimeAverage is the long-term average round time of the network,
+	// combined with the Metrics into the RoundTime estimate.
+	RoundTimeAverage RoundTimeAverage
+
+	// Performance holds the block proposal statistics of the Accounts,
+	// collected from the blocks seen while watching the node.
+	Performance Performance
+
 	// ParticipationKeys is a slice of participation keys used by the node
 	// to interact with the blockchain and consensus protocol.
 	ParticipationKeys participation.List
@@ -38,11 +51,6 @@ type StateModel struct {
 	// admin privileges or capabilities enabled.
 	Admin bool
 
-	// Watching indicates whether the StateModel is actively monitoring
-	// changes or processes in a background loop.
-	// TODO: handle contexts instead of adding it to state (skill-issue zero)
-	Watching bool
-
 	// Whether user has disabled automatically applying incentive eligibility fees
 	IncentivesDisabled bool
 
@@ -61,6 +69,20 @@ type StateModel struct {
 	// Algod Config
 	Config  *config.Config
 	DataDir string
+	// Profile is the role profile applied to the config.json, nil when none was applied
+	Profile *config.AppliedProfile
+	// Service is how algod is managed on the host and the resources of its process, nil for remote nodes
+	Service *ServiceStatus
+
+	// broker delivers watcher events to subscribers
+	// and holds the cancellation of the running watcher
+	broker *broker
+
+	// serviceUpdatedAt is when the Service was last refreshed
+	serviceUpdatedAt time.Time
+
+	// accountCache holds the accounts fetched by UpdateKeys and the round they were fetched at
+	accountCache accountCache
 }
 
 // NewStateModel initializes and returns a new StateModel instance
@@ -82,9 +104,21 @@ func NewStateModel(ctx context.Context, client api.ClientWithResponsesInterface,
 		log.Errorf("Failed to fetch participation keys from node: %s", err)
 	}
 
-	algodConfig, err := utils.GetConfigFromDataDir(dataDir)
-	if err != nil {
-		log.Errorf("Unable to open config.json: %s", err)
+	// Remote nodes have no data directory to read the config from
+	algodConfig := new(config.Config)
+	var profile *config.AppliedProfile
+	var service *ServiceStatus
+	if dataDir != "" {
+		algodConfig, err = utils.GetConfigFromDataDir(dataDir)
+		if err != nil {
+			log.Errorf("Unable to open config.json: %s", err)
+		}
+		profile, err = utils.GetProfileFromDataDir(dataDir)
+		if err != nil {
+			log.Errorf("Unable to open %s: %s", utils.ProfileFilename, err)
+		}
+		status := GetServiceStatus(system.Clock{}, dataDir)
+		service = &status
 	}
 
 	return &StateModel{
@@ -93,8 +127,7 @@ func NewStateModel(ctx context.Context, client api.ClientWithResponsesInterface,
 		Accounts:          ParticipationKeysToAccounts(partKeys),
 		ParticipationKeys: partKeys,
 
-		Admin:    true,
-		Watching: true,
+		Admin: true,
 
 		Version: version,
 		Client:  client,
@@ -102,118 +135,206 @@ func NewStateModel(ctx context.Context, client api.ClientWithResponsesInterface,
 		Context: ctx,
 		Config:  algodConfig,
 		DataDir: dataDir,
+		Profile: profile,
+		Service: service,
 
 		IncentivesDisabled: incentivesDisabled,
 	}, partkeysResponse, nil
 }
 
-// waitAfterError updates the state to "DOWN", invokes the callback with an error, and pauses for a fixed duration if an error occurs.
-// TODO: handle in context loop
-func (s *StateModel) waitAfterError(err error, cb func(model *StateModel, err error)) {
+// errorBackoff is how long the watcher waits before retrying a node that returned an error.
+const errorBackoff = time.Second * 3
+
+// catchupInterval is how often the watcher polls the status during a Fast-Catchup.
+const catchupInterval = time.Second * 2
+
+// sleep pauses for the duration or until the context is done, returning the context error if it was.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// setDown marks the node as "DOWN" and publishes a NodeDownEvent when it was previously up.
+func (s *StateModel) setDown(ctx context.Context, err error) {
+	if s.Status.State == DownState {
+		return
+	}
+	s.Status.State = DownState
+	s.publish(ctx, NodeDownEvent, err)
+}
+
+// setStatus applies a status response and publishes the events describing the difference.
+// It returns false when the node responded with an error.
+func (s *StateModel) setStatus(ctx context.Context, status Status, err error) bool {
 	if err != nil {
-		s.Status.State = "DOWN"
-		cb(nil, err)
-		time.Sleep(time.Second * 3)
+		s.setDown(ctx, err)
+		return false
 	}
+	previous := s.Status
+	s.Status = status
+	if previous.State == DownState {
+		s.publish(ctx, NodeUpEvent, nil)
+	}
+	// Any field, like the progress of a Fast-Catchup where the round does not move
+	if !reflect.DeepEqual(previous, status) {
+		s.publish(ctx, StatusChangedEvent, nil)
+	}
+	if previous.LastRound != status.LastRound {
+		s.publish(ctx, NewRoundEvent, nil)
+	}
+	return true
 }
 
-// Watch starts monitoring the state in a loop, invoking a callback with updates or errors as they occur.
-// TODO: allow context to handle loop
-func (s *StateModel) Watch(cb func(model *StateModel, err error), ctx context.Context, t system.Time) {
-	var err error
+// Watch monitors the node until the context is cancelled or Stop is called,
+// publishing an Event to every subscriber when the state changes.
+// Subscriber channels are closed when Watch returns.
+func (s *StateModel) Watch(ctx context.Context, t system.Time) error {
+	ctx, cancel := context.WithCancel(ctx)
+	b := s.events()
+	b.mu.Lock()
+	b.cancel = cancel
+	b.mu.Unlock()
+	defer cancel()
+	defer s.closeSubscribers()
 
 	// Setup Defaults
-	s.Watching = true
 	if s.Metrics.Window == 0 {
 		s.Metrics.Window = 100
 	}
 
 	// Fetch the latest Status
-	s.Status, _, err = s.Status.Get(ctx)
-	if err != nil {
-		// callback immediately on error
-		cb(nil, err)
-	}
+	status, _, err := s.Status.Get(ctx)
+	s.setStatus(ctx, status, err)
 
 	// The main Loop
-	// TODO: Refactor to Context
-	for {
-		if !s.Watching {
-			break
+	lastTick := t.Now()
+	for ctx.Err() == nil {
+		// The node waits up to a minute for a block, a stalled node still ticks
+		if now := t.Now(); now.Sub(lastTick) >= TickInterval {
+			lastTick = now
+			s.publish(ctx, TickEvent, nil)
 		}
-
 		// Abort on Fast-Catchup
 		if s.Status.State == FastCatchupState {
-			// Update current render
-			cb(s, nil)
-			// Wait for a while
-			time.Sleep(time.Second * 2)
-			// Check status
-			s.Status, _, err = s.Status.Get(ctx)
-			// Report errors
-			if err != nil {
-				cb(nil, err)
+			if sleep(ctx, catchupInterval) != nil {
+				break
 			}
-			// Update render after status fetch
-			cb(s, nil)
+			status, _, err = s.Status.Get(ctx)
+			s.setStatus(ctx, status, err)
 			continue
 		}
+
 		// Fetch Keys
 		s.UpdateKeys(ctx, t)
-		cb(s, nil)
+		// Refresh the service, also while the node is down
+		s.updateService(ctx, t)
 
 		// Wait for the next block
-		s.Status, _, err = s.Status.Wait(ctx)
-		s.waitAfterError(err, cb)
-		if err != nil {
+		status, _, err = s.Status.Wait(ctx)
+		if ctx.Err() != nil {
+			break
+		}
+		if !s.setStatus(ctx, status, err) {
+			_ = sleep(ctx, errorBackoff)
 			continue
 		}
 
 		if s.Status.State == SyncingState {
-			cb(s, nil)
 			continue
 		}
+		// Scan the new block for proposals, nodes without the supply endpoint are skipped
+		_ = s.Performance.Update(ctx, s.Client, s.Status.LastRound, s.Accounts)
+		if ctx.Err() != nil {
+			break
+		}
 		// Run Round Averages and RX/TX every 5 rounds
 		if s.Status.LastRound%5 == 0 {
 			s.Metrics, _, err = s.Metrics.Get(ctx, s.Status.LastRound)
-			s.waitAfterError(err, cb)
+			if ctx.Err() != nil {
+				break
+			}
 			if err != nil {
+				s.setDown(ctx, err)
+				_ = sleep(ctx, errorBackoff)
 				continue
 			}
+			s.RoundTimeAverage = s.RoundTimeAverage.Add(s.Metrics.RoundTime, s.Metrics.Window, s.Status.LastRound)
+			s.publish(ctx, MetricsUpdatedEvent, nil)
 		}
-
-		// Callback the current state to the app
-		cb(s, nil)
 	}
+
+	return ctx.Err()
 }
 
-// Stop halts the monitoring process by setting the Watching field to false.
+// Stop halts a running Watch by cancelling its context.
 func (s *StateModel) Stop() {
-	s.Watching = false
+	b := s.events()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.cancel != nil {
+		b.cancel()
+	}
 }
 
 // UpdateKeys retrieves and updates participation keys, manages admin status, and synchronizes account data with the node.
+// Accounts are fetched concurrently and reused between rounds, see accountCache,
+// and KeysChangedEvent is only published when the keys or the accounts changed.
 func (s *StateModel) UpdateKeys(ctx context.Context, t system.Time) {
-	var err error
-	s.ParticipationKeys, _, err = participation.GetList(ctx, s.Client)
+	keys, _, err := participation.GetList(ctx, s.Client)
+	keysChanged := !reflect.DeepEqual(s.ParticipationKeys, keys)
 	if err != nil {
 		s.Admin = false
+		s.ParticipationKeys = keys
+		if keysChanged {
+			s.publish(ctx, KeysChangedEvent, nil)
+		}
+		return
 	}
-	if err == nil {
-		s.Admin = true
-		s.Accounts = ParticipationKeysToAccounts(s.ParticipationKeys)
+	s.Admin = true
 
-		// For each account, update the data from the RPC endpoint
-		for _, acct := range s.Accounts {
-			// Skip eon errors
-			rpcAcct, err := GetAccount(s.Client, acct.Address)
-			if err != nil {
-				continue
-			}
-			s.Accounts[acct.Address] = s.Accounts[acct.Address].Merge(rpcAcct)
-			s.Accounts[acct.Address] = s.Accounts[acct.Address].UpdateExpiredTime(t, s.ParticipationKeys, int(s.Status.LastRound), s.Metrics.RoundTime)
-			s.Accounts[acct.Address] = s.Accounts[acct.Address].PatchOnlineStatus(rpcAcct, int(s.Status.LastRound))
+	if s.accountCache == nil {
+		s.accountCache = make(accountCache)
+	}
+	s.accountCache.invalidate(s.ParticipationKeys, keys)
+	round := s.Status.LastRound
+	accounts := AddWatchAccounts(ParticipationKeysToAccounts(keys), s.WatchAccounts)
+	s.accountCache.prune(accounts)
+
+	// Fetch the accounts that are missing or out of date, errors keep the previous data
+	var stale []string
+	for address := range accounts {
+		if s.accountCache.isStale(address, keys, round) {
+			stale = append(stale, address)
+		}
+	}
+	fetched, _ := GetAccounts(ctx, s.Client, stale, accountWorkers)
+	for address, rpcAcct := range fetched {
+		s.accountCache[address] = cachedAccount{account: rpcAcct, round: round}
+	}
+
+	roundTime := s.RoundTime()
+	for address, acct := range accounts {
+		entry, ok := s.accountCache[address]
+		if !ok {
+			continue
 		}
+		acct = acct.Merge(entry.account)
+		acct = acct.UpdateExpiredTime(t, keys, int(round), roundTime)
+		accounts[address] = acct.PatchOnlineStatus(entry.account, int(round))
+	}
 
+	s.ParticipationKeys = keys
+	accountsChanged := !accountsEqual(s.Accounts, accounts)
+	if accountsChanged || s.Accounts == nil {
+		s.Accounts = accounts
+	}
+	if keysChanged || accountsChanged {
+		s.publish(ctx, KeysChangedEvent, nil)
 	}
 }
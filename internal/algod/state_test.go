@@ -5,6 +5,7 @@ import (
 	"github.com/algorandfoundation/nodekit/api"
 	"github.com/algorandfoundation/nodekit/internal/test"
 	"github.com/algorandfoundation/nodekit/internal/test/mock"
+	"sync"
 	"testing"
 	"time"
 )
@@ -13,7 +14,6 @@ func Test_StateModel(t *testing.T) {
 	client := test.GetClient(false)
 	httpPkg := new(api.HttpPkg)
 	state := StateModel{
-		Watching: true,
 		Status: Status{
 			LastRound:   1337,
 			NeedsUpdate: true,
@@ -32,17 +32,32 @@ func Test_StateModel(t *testing.T) {
 		Client:  client,
 		Context: context.Background(),
 	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	events, unsubscribe := state.Subscribe()
+	defer unsubscribe()
+
+	// A second subscriber receives the same events
+	others, _ := state.Subscribe()
+	go func() {
+		for range others {
+		}
+	}()
+
 	count := 0
-	go state.Watch(func(model *StateModel, err error) {
-		if err != nil || model == nil {
+	go func() {
+		err := state.Watch(ctx, new(mock.Clock))
+		if err != context.DeadlineExceeded {
+			t.Error("Watch should return the context error")
+		}
+	}()
+	for event := range events {
+		if event.Err != nil || event.State == nil {
 			t.Error("Failed")
-			return
+			continue
 		}
 		count++
-	}, context.Background(), new(mock.Clock))
-	time.Sleep(5 * time.Second)
-	// Stop the watcher
-	state.Stop()
+	}
 	if count == 0 {
 		t.Fatal("Did not receive any updates")
 	}
@@ -50,7 +65,6 @@ func Test_StateModel(t *testing.T) {
 		t.Fatal("LastRound is stale")
 	}
 	t.Log(
-		"Watching: ", state.Watching,
 		"LastRound: ", state.Status.LastRound,
 		"NeedsUpdate: ", state.Status.NeedsUpdate,
 		"State: ", state.Status.State,
@@ -65,7 +79,6 @@ func Test_UpdateKeys(t *testing.T) {
 	client := test.GetClient(false)
 	httpPkg := new(api.HttpPkg)
 	state := StateModel{
-		Watching: true,
 		Status: Status{
 			LastRound:   1000000000,
 			NeedsUpdate: true,
@@ -93,3 +106,104 @@ func Test_UpdateKeys(t *testing.T) {
 		t.Fatal("Account should be offline")
 	}
 }
+
+func Test_StateModelStop(t *testing.T) {
+	client := test.GetClient(false)
+	state := StateModel{
+		Status:  Status{State: StableState, Client: client},
+		Metrics: Metrics{Client: client},
+		Client:  client,
+	}
+	events, unsubscribe := state.Subscribe()
+	done := make(chan error)
+	go func() {
+		done <- state.Watch(context.Background(), new(mock.Clock))
+	}()
+
+	// Receive at least one event before stopping
+	<-events
+	unsubscribe()
+	// The channel is closed once unsubscribed
+	for range events {
+	}
+
+	state.Stop()
+	select {
+	case err := <-done:
+		if err != context.Canceled {
+			t.Fatal("Watch should return context.Canceled, got", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Watch did not stop")
+	}
+}
+
+func Test_SetStatusCatchup(t *testing.T) {
+	catchpoint := "1000#ABC"
+	state := StateModel{Status: Status{State: FastCatchupState, LastRound: 100, Catchpoint: &catchpoint}}
+	events, unsubscribe := state.Subscribe()
+	defer unsubscribe()
+
+	// The round and state do not move during a Fast-Catchup, only its progress
+	status := state.Status
+	status.CatchpointBlocksAcquired = 10
+	if !state.setStatus(context.Background(), status, nil) {
+		t.Fatal("setStatus should accept the status")
+	}
+	select {
+	case event := <-events:
+		if event.Type != StatusChangedEvent {
+			t.Fatalf("expected %s, got %s", StatusChangedEvent, event.Type)
+		}
+	default:
+		t.Fatal("the progress of the Fast-Catchup was not published")
+	}
+
+	// An identical status publishes nothing
+	state.setStatus(context.Background(), status, nil)
+	select {
+	case event := <-events:
+		t.Fatalf("unexpected %s", event.Type)
+	default:
+	}
+}
+
+// tickClock moves forward by a TickInterval on every read.
+type tickClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *tickClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(TickInterval)
+	return c.now
+}
+
+func Test_WatchTick(t *testing.T) {
+	client := test.GetClient(false)
+	state := StateModel{
+		Status:  Status{State: StableState, Client: client},
+		Metrics: Metrics{Client: client},
+		Client:  client,
+	}
+	events, unsubscribe := state.Subscribe()
+	defer unsubscribe()
+	go func() {
+		_ = state.Watch(context.Background(), new(tickClock))
+	}()
+	defer state.Stop()
+
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case event := <-events:
+			if event.Type == TickEvent {
+				return
+			}
+		case <-timeout:
+			t.Fatal("the watcher did not tick")
+		}
+	}
+}
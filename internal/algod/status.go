@@ -23,6 +23,9 @@ const (
 
 	// StableState indicates the system is in a stable and operational state with no ongoing synchronization or major updates.
 	StableState State = "RUNNING"
+
+	// DownState indicates the node did not respond to the last request.
+	DownState State = "DOWN"
 )
 
 // Status represents the state of a system including metadata like version, network, and operational state.
@@ -0,0 +1,114 @@
+package algod
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/v2/types"
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod/participation"
+	"github.com/algorandfoundation/nodekit/internal/algod/signer"
+)
+
+// DefaultValidRounds is the number of rounds a new transaction is valid for.
+const DefaultValidRounds = 1000
+
+// DefaultConfirmationRounds is how many rounds to wait for a submitted transaction to be confirmed.
+const DefaultConfirmationRounds = 10
+
+// GetSuggestedParams fetches the parameters for a new transaction from the node.
+// The transaction is valid from the last round for the number of rounds.
+func GetSuggestedParams(ctx context.Context, client api.ClientWithResponsesInterface, validRounds int) (types.SuggestedParams, api.ResponseInterface, error) {
+	var params types.SuggestedParams
+	response, err := client.TransactionParamsWithResponse(ctx)
+	if err != nil {
+		return params, response, err
+	}
+	if response.StatusCode() != 200 {
+		return params, response, errors.New(response.Status())
+	}
+	return types.SuggestedParams{
+		Fee:              types.MicroAlgos(response.JSON200.Fee),
+		GenesisID:        response.JSON200.GenesisId,
+		GenesisHash:      response.JSON200.GenesisHash,
+		FirstRoundValid:  types.Round(response.JSON200.LastRound),
+		LastRoundValid:   types.Round(response.JSON200.LastRound + validRounds),
+		ConsensusVersion: response.JSON200.ConsensusVersion,
+		MinFee:           uint64(response.JSON200.MinFee),
+	}, response, nil
+}
+
+// SendRawTransaction submits an encoded signed transaction to the node and returns its id.
+func SendRawTransaction(ctx context.Context, client api.ClientWithResponsesInterface, stx []byte) (string, api.ResponseInterface, error) {
+	response, err := client.RawTransactionWithBodyWithResponse(ctx, "application/x-binary", bytes.NewReader(stx))
+	if err != nil {
+		return "", response, err
+	}
+	if response.StatusCode() != 200 {
+		if response.JSON400 != nil {
+			return "", response, errors.New(response.JSON400.Message)
+		}
+		return "", response, errors.New(response.Status())
+	}
+	return response.JSON200.TxId, response, nil
+}
+
+// WaitForConfirmation waits up to a number of rounds for the transaction to be confirmed and returns the confirmed round.
+func WaitForConfirmation(ctx context.Context, client api.ClientWithResponsesInterface, txid string, rounds int) (int, error) {
+	status, err := client.GetStatusWithResponse(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if status.StatusCode() != 200 {
+		return 0, errors.New(status.Status())
+	}
+
+	var format api.PendingTransactionInformationParamsFormat = "json"
+	round := status.JSON200.LastRound
+	for end := round + rounds; round < end; round++ {
+		pending, err := client.PendingTransactionInformationWithResponse(ctx, txid, &api.PendingTransactionInformationParams{Format: &format})
+		if err != nil {
+			return 0, err
+		}
+		if pending.StatusCode() != 200 {
+			return 0, errors.New(pending.Status())
+		}
+		if pending.JSON200.ConfirmedRound != nil && *pending.JSON200.ConfirmedRound > 0 {
+			return *pending.JSON200.ConfirmedRound, nil
+		}
+		if pending.JSON200.PoolError != "" {
+			return 0, fmt.Errorf("transaction %s was rejected: %s", txid, pending.JSON200.PoolError)
+		}
+		_, err = client.WaitForBlockWithResponse(ctx, round)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return 0, fmt.Errorf("transaction %s was not confirmed after %d rounds", txid, rounds)
+}
+
+// MakeKeyRegTxn builds the online or offline key registration for the participation key.
+// Online registrations pay the incentive eligibility fee when the account should.
+func MakeKeyRegTxn(part api.ParticipationKey, acct Account, offline bool, incentivesDisabled bool, params types.SuggestedParams) (types.Transaction, error) {
+	if offline {
+		return participation.MakeOfflineKeyRegTxn(part.Address, params)
+	}
+	return participation.MakeOnlineKeyRegTxn(part, params, acct.ShouldAddIncentivesFee(incentivesDisabled))
+}
+
+// SignAndSend signs the transaction, submits it to the node and waits for it to be confirmed.
+// It returns the transaction id and the confirmed round.
+func SignAndSend(ctx context.Context, client api.ClientWithResponsesInterface, s signer.Signer, txn types.Transaction) (string, int, error) {
+	stx, err := signer.Sign(s, txn)
+	if err != nil {
+		return "", 0, err
+	}
+	txid, _, err := SendRawTransaction(ctx, client, stx)
+	if err != nil {
+		return "", 0, err
+	}
+	round, err := WaitForConfirmation(ctx, client, txid, DefaultConfirmationRounds)
+	return txid, round, err
+}
@@ -0,0 +1,66 @@
+package algod
+
+import (
+	"context"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/mnemonic"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+	"github.com/algorandfoundation/nodekit/internal/algod/signer"
+	"github.com/algorandfoundation/nodekit/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetSuggestedParams(t *testing.T) {
+	params, _, err := GetSuggestedParams(context.Background(), test.GetClient(false), 100)
+	assert.Nil(t, err)
+	assert.Equal(t, "tui-net", params.GenesisID)
+	assert.Equal(t, uint64(10), uint64(params.FirstRoundValid))
+	assert.Equal(t, uint64(110), uint64(params.LastRoundValid))
+
+	_, _, err = GetSuggestedParams(context.Background(), test.NewClient(false, true), 100)
+	assert.NotNil(t, err)
+
+	_, _, err = GetSuggestedParams(context.Background(), test.GetClient(true), 100)
+	assert.NotNil(t, err)
+}
+
+func Test_SendRawTransaction(t *testing.T) {
+	txid, _, err := SendRawTransaction(context.Background(), test.GetClient(false), []byte{})
+	assert.Nil(t, err)
+	assert.Equal(t, "TXID", txid)
+
+	_, _, err = SendRawTransaction(context.Background(), test.NewClient(false, true), []byte{})
+	assert.EqualError(t, err, "transaction rejected")
+
+	_, _, err = SendRawTransaction(context.Background(), test.GetClient(true), []byte{})
+	assert.NotNil(t, err)
+}
+
+func Test_WaitForConfirmation(t *testing.T) {
+	round, err := WaitForConfirmation(context.Background(), test.GetClient(false), "TXID", 5)
+	assert.Nil(t, err)
+	assert.Equal(t, 11, round)
+
+	_, err = WaitForConfirmation(context.Background(), test.GetClient(true), "TXID", 5)
+	assert.NotNil(t, err)
+}
+
+func Test_SignAndSend(t *testing.T) {
+	account := crypto.GenerateAccount()
+	phrase, err := mnemonic.FromPrivateKey(account.PrivateKey)
+	assert.Nil(t, err)
+	s, err := signer.NewMnemonicSigner(phrase)
+	assert.Nil(t, err)
+
+	txn := types.Transaction{Type: types.KeyRegistrationTx, Header: types.Header{Sender: account.Address}}
+	txid, round, err := SignAndSend(context.Background(), test.GetClient(false), s, txn)
+	assert.Nil(t, err)
+	assert.Equal(t, "TXID", txid)
+	assert.Equal(t, 11, round)
+
+	txn.Sender = crypto.GenerateAccount().Address
+	_, _, err = SignAndSend(context.Background(), test.GetClient(false), s, txn)
+	assert.ErrorIs(t, err, signer.ErrWrongAccount)
+}
@@ -7,9 +7,9 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
-	"syscall"
 	"time"
 
+	"github.com/algorandfoundation/nodekit/internal/algod/backup"
 	"github.com/algorandfoundation/nodekit/internal/algod/config"
 	"github.com/algorandfoundation/nodekit/internal/algod/telemetry"
 	"github.com/algorandfoundation/nodekit/internal/system"
@@ -181,13 +181,19 @@ func GetLogConfigFromDataDir(path string) (*telemetry.Config, error) {
 }
 
 // WriteLogConfigToDataDir writes the provided telemetry log configuration to a file in the specified data directory.
-// The configuration is formatted as indented JSON and saved to a file named "logging.config".
+// The configuration is formatted as indented JSON and saved to a file named "logging.config",
+// the previous file is saved in the history of the data directory.
 func WriteLogConfigToDataDir(path string, logConfig *telemetry.Config) error {
 	file, err := json.MarshalIndent(logConfig, "", " ")
 	if err != nil {
 		return err
 	}
-	err = os.WriteFile(filepath.Join(path, "logging.config"), file, 0o644)
+	logConfigFile := filepath.Join(path, "logging.config")
+	_, err = backup.Snapshot(path, logConfigFile)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(logConfigFile, file, 0o644)
 	if err != nil {
 		return err
 	}
@@ -197,106 +203,117 @@ func WriteLogConfigToDataDir(path string, logConfig *telemetry.Config) error {
 // GetConfigFromDataDir reads a node configuration file from the
 // specific data directory and unmarshals it into a config.Config.
 func GetConfigFromDataDir(path string) (*config.Config, error) {
+	algodConfig, _, err := ReadConfigFromDataDir(path)
+	return algodConfig, err
+}
+
+// ReadConfigFromDataDir reads a node configuration file from the specific data directory,
+// returning the warnings about the keys algod does not know. A missing file is an empty configuration.
+func ReadConfigFromDataDir(path string) (*config.Config, []string, error) {
 	var algodConfig config.Config
 
 	file, err := os.ReadFile(filepath.Join(path, "config.json"))
 	if err != nil {
 		if os.IsNotExist(err) {
-			return &algodConfig, nil
+			return &algodConfig, nil, nil
 		}
-		return &algodConfig, err
+		return &algodConfig, nil, err
 	}
 
-	err = json.Unmarshal(file, &algodConfig)
+	algodConfig, warnings, err := config.Parse(file)
 	if err != nil {
-		return &algodConfig, err
+		return &algodConfig, warnings, err
 	}
 
-	return &algodConfig, nil
+	return &algodConfig, warnings, nil
 }
 
 // WriteConfigToDataDir writes the provided node configuration to a file in the specified data directory.
 // The configuration is formatted as indented JSON and saved to a file named "config.json".
+// Only the set fields are written, the other keys of an existing file are kept.
 func WriteConfigToDataDir(path string, algodConfig *config.Config) error {
-	// Read an existing config and unmarshal it into a map, or make a new map.
-	var currentConfigMap map[string]json.RawMessage
-	configFile := filepath.Join(path, "config.json")
-	file, err := os.ReadFile(configFile)
-	if err == nil {
-		if err := json.Unmarshal(file, &currentConfigMap); err != nil {
-			return err
-		}
-	} else if os.IsPermission(err) {
+	// Never write values that algod would refuse to start with
+	if err := algodConfig.Validate(); err != nil {
 		return err
-	} else if !os.IsNotExist(err) {
-		return err
-	} else {
-		currentConfigMap = make(map[string]json.RawMessage)
 	}
 
-	// We only want user-defined values (non-nil), so omitempty removes
-	// everything else when unmarshaling into newConfigMap.
-	tempConfigMap, err := json.Marshal(algodConfig)
+	// Read an existing config, or start a new one.
+	file, err := GetConfigFileFromDataDir(path)
 	if err != nil {
 		return err
 	}
-	var newConfigMap map[string]json.RawMessage
-	if err := json.Unmarshal(tempConfigMap, &newConfigMap); err != nil {
+	newConfig, err := config.Apply(file, *algodConfig, nil)
+	if err != nil {
 		return err
 	}
+	return WriteConfigFileToDataDir(path, newConfig)
+}
 
-	// Update currentConfigMap with user-defined values.
-	for key, value := range newConfigMap {
-		currentConfigMap[key] = value
+// GetConfigFileFromDataDir reads the config.json of the data directory, a missing file has no content.
+func GetConfigFileFromDataDir(path string) ([]byte, error) {
+	file, err := os.ReadFile(filepath.Join(path, "config.json"))
+	if os.IsNotExist(err) {
+		return nil, nil
 	}
+	return file, err
+}
 
-	// Marshal and save the new config
-	newConfig, err := json.MarshalIndent(currentConfigMap, "", "\t")
+// WriteConfigFileToDataDir replaces the config.json of the data directory,
+// the previous file and its applied profile are saved in the history of the data directory.
+func WriteConfigFileToDataDir(path string, data []byte) error {
+	configFile := filepath.Join(path, "config.json")
+	_, err := backup.Snapshot(path, configFile, filepath.Join(path, ProfileFilename))
 	if err != nil {
 		return err
 	}
-	err = os.WriteFile(filepath.Join(path, "config.json"), newConfig, 0o664)
+
+	err = os.WriteFile(configFile, data, 0o664)
 	if err != nil {
 		return err
 	}
 
 	// If we're sudo'ed set permissions/ownership on the config file
 	if system.IsSudo() {
-		return setFilePermissions(configFile)
+		return system.SetFilePermissions(configFile)
 	}
 
 	return nil
 }
 
-// setFilePermissions matches a file's user and group
-// to its parent directory, and sets perms to 0o664.
-// Will only succeed if run as root (sudo)
-func setFilePermissions(filePath string) error {
-	// Get the parent directory info
-	dirPath := filepath.Dir(filePath)
-	dirInfo, err := os.Stat(dirPath)
+// ProfileFilename records the profile applied to the config.json of the data directory.
+const ProfileFilename = "nodekit.profile.json"
+
+// GetProfileFromDataDir reads the profile applied to the data directory, nil when none was applied.
+func GetProfileFromDataDir(path string) (*config.AppliedProfile, error) {
+	file, err := os.ReadFile(filepath.Join(path, ProfileFilename))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	// Extract ownership info from the directory.
-	// This is specific to Unix-like systems (Linux and macOS)
-	stat, ok := dirInfo.Sys().(*syscall.Stat_t)
-	if !ok {
-		return fmt.Errorf("unable to get ownership details on file: %s", dirPath)
+	var profile config.AppliedProfile
+	err = json.Unmarshal(file, &profile)
+	if err != nil {
+		return nil, err
 	}
+	return &profile, nil
+}
 
-	// Apply ownership permissions to file
-	uid := int(stat.Uid)
-	gid := int(stat.Gid)
-	if err := os.Chown(filePath, uid, gid); err != nil {
+// WriteProfileToDataDir records the profile applied to the data directory.
+func WriteProfileToDataDir(path string, profile config.AppliedProfile) error {
+	file, err := json.MarshalIndent(profile, "", " ")
+	if err != nil {
 		return err
 	}
-	// Also make sure group can write in the future
-	if err := os.Chmod(filePath, 0o664); err != nil {
+	profileFile := filepath.Join(path, ProfileFilename)
+	err = os.WriteFile(profileFile, file, 0o664)
+	if err != nil {
 		return err
 	}
-
+	if system.IsSudo() {
+		return system.SetFilePermissions(profileFile)
+	}
 	return nil
 }
 
@@ -0,0 +1,35 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/algod/backup"
+	"github.com/algorandfoundation/nodekit/internal/algod/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_WriteConfigFileToDataDir(t *testing.T) {
+	dataDir := t.TempDir()
+	assert.Nil(t, WriteConfigFileToDataDir(dataDir, []byte(`{"Archival": true}`)))
+	assert.Nil(t, WriteProfileToDataDir(dataDir, config.AppliedProfile{Name: "archival", AppliedAt: time.Now()}))
+
+	// The applied profile is restored with the config.json it describes
+	assert.Nil(t, WriteConfigFileToDataDir(dataDir, []byte(`{}`)))
+	_, err := backup.Restore(dataDir, 2)
+	assert.Nil(t, err)
+	profile, err := GetProfileFromDataDir(dataDir)
+	assert.Nil(t, err)
+	assert.Equal(t, "archival", profile.Name)
+	data, err := os.ReadFile(filepath.Join(dataDir, "config.json"))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"Archival": true}`, string(data))
+
+	_, err = backup.Restore(dataDir, 1)
+	assert.Nil(t, err)
+	profile, err = GetProfileFromDataDir(dataDir)
+	assert.Nil(t, err)
+	assert.Nil(t, profile)
+}
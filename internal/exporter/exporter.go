@@ -0,0 +1,209 @@
+package exporter
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/participation"
+	"github.com/algorandfoundation/nodekit/internal/system"
+)
+
+// Namespace prefixes every metric exposed by the exporter.
+const Namespace = "nodekit"
+
+// ContentType is the Prometheus text exposition format content type.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// States lists the node states reported by the nodekit_node_state gauge.
+var States = []algod.State{
+	algod.StableState,
+	algod.SyncingState,
+	algod.FastCatchupState,
+	algod.DownState,
+}
+
+// Label is a single name/value pair attached to a sample.
+type Label struct {
+	Name  string
+	Value string
+}
+
+// Sample is a single value of a metric with its labels.
+type Sample struct {
+	Labels []Label
+	Value  float64
+}
+
+// Gauge is a metric family rendered with the gauge type.
+type Gauge struct {
+	Name    string
+	Help    string
+	Samples []Sample
+}
+
+// escapeLabel escapes a label value for the text exposition format.
+func escapeLabel(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, "\n", `\n`)
+	return strings.ReplaceAll(value, `"`, `\"`)
+}
+
+// boolToFloat converts a bool into a 1 or 0 sample value.
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// WriteTo renders the gauge in the Prometheus text exposition format.
+func (g Gauge) WriteTo(w io.Writer) (int64, error) {
+	var b bytes.Buffer
+	name := fmt.Sprintf("%s_%s", Namespace, g.Name)
+	fmt.Fprintf(&b, "# HELP %s %s\n", name, g.Help)
+	fmt.Fprintf(&b, "# TYPE %s gauge\n", name)
+	for _, sample := range g.Samples {
+		b.WriteString(name)
+		if len(sample.Labels) > 0 {
+			labels := make([]string, 0, len(sample.Labels))
+			for _, label := range sample.Labels {
+				labels = append(labels, fmt.Sprintf(`%s="%s"`, label.Name, escapeLabel(label.Value)))
+			}
+			b.WriteString("{" + strings.Join(labels, ",") + "}")
+		}
+		b.WriteString(" " + strconv.FormatFloat(sample.Value, 'g', -1, 64) + "\n")
+	}
+	n, err := w.Write(b.Bytes())
+	return int64(n), err
+}
+
+// lastRounds returns the most recent vote and block proposal rounds of the keys belonging to the address.
+func lastRounds(keys participation.List, address string) (int, int) {
+	var vote, proposal int
+	for _, key := range keys {
+		if key.Address != address {
+			continue
+		}
+		if key.LastVote != nil && *key.LastVote > vote {
+			vote = *key.LastVote
+		}
+		if key.LastBlockProposal != nil && *key.LastBlockProposal > proposal {
+			proposal = *key.LastBlockProposal
+		}
+	}
+	return vote, proposal
+}
+
+// Gauges derives the exported metrics from the StateModel.
+func Gauges(state *algod.StateModel, t system.Time) []Gauge {
+	up := state.Status.State != algod.DownState && state.Status.State != ""
+	states := make([]Sample, 0, len(States))
+	for _, s := range States {
+		states = append(states, Sample{
+			Labels: []Label{{"state", string(s)}},
+			Value:  boolToFloat(state.Status.State == s),
+		})
+	}
+	node := []Label{{"network", state.Status.Network}, {"version", state.Status.Version}}
+
+	gauges := []Gauge{
+		{"node_up", "Whether the node responded to the last request.", []Sample{{Value: boolToFloat(up)}}},
+		{"node_info", "Information about the node, the value is always 1.", []Sample{{Labels: node, Value: 1}}},
+		{"node_state", "The current state of the node, one series per state.", states},
+		{"node_last_round", "The last round seen by the node.", []Sample{{Value: float64(state.Status.LastRound)}}},
+		{"node_needs_update", "Whether a newer algod release is available.", []Sample{{Value: boolToFloat(state.Status.NeedsUpdate)}}},
+		{"round_time_seconds", "The average round time over the metrics window.", []Sample{{Value: state.Metrics.RoundTime.Seconds()}}},
+		{"transactions_per_second", "The average transactions per second over the metrics window.", []Sample{{Value: state.Metrics.TPS}}},
+		{"network_rx_bytes_per_second", "The rate of bytes received by the node.", []Sample{{Value: float64(state.Metrics.RX)}}},
+		{"network_tx_bytes_per_second", "The rate of bytes sent by the node.", []Sample{{Value: float64(state.Metrics.TX)}}},
+		{"participation_keys", "The number of participation keys installed on the node.", []Sample{{Value: float64(len(state.ParticipationKeys))}}},
+	}
+
+	// Sort the accounts so the output is stable between scrapes
+	addresses := make([]string, 0, len(state.Accounts))
+	for address := range state.Accounts {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	var expires, participating, eligible, nonResident, balance, keys, lastVote, lastProposal []Sample
+	now := t.Now()
+	for _, address := range addresses {
+		acct := state.Accounts[address]
+		labels := []Label{{"address", address}}
+		if acct.Expires != nil {
+			expires = append(expires, Sample{labels, acct.Expires.Sub(now).Seconds()})
+		}
+		isParticipating := acct.Status == "Online" &&
+			acct.Participation != nil &&
+			acct.Participation.VoteLastValid >= int(state.Status.LastRound)
+		participating = append(participating, Sample{labels, boolToFloat(isParticipating)})
+		eligible = append(eligible, Sample{labels, boolToFloat(acct.IncentiveEligible)})
+		nonResident = append(nonResident, Sample{labels, boolToFloat(acct.NonResidentKey)})
+		balance = append(balance, Sample{labels, float64(acct.Balance)})
+		keys = append(keys, Sample{labels, float64(acct.Keys)})
+		vote, proposal := lastRounds(state.ParticipationKeys, address)
+		lastVote = append(lastVote, Sample{labels, float64(vote)})
+		lastProposal = append(lastProposal, Sample{labels, float64(proposal)})
+	}
+
+	return append(gauges,
+		Gauge{"account_key_expires_seconds", "Estimated seconds until the registered participation key expires.", expires},
+		Gauge{"account_participating", "Whether the account is online with a valid participation key.", participating},
+		Gauge{"account_incentive_eligible", "Whether the account is eligible for block rewards.", eligible},
+		Gauge{"account_non_resident_key", "Whether the account is online with a key that is not on this node.", nonResident},
+		Gauge{"account_balance_algos", "The account balance in ALGO.", balance},
+		Gauge{"account_participation_keys", "The number of participation keys on the node for the account.", keys},
+		Gauge{"account_last_vote_round", "The last round the account voted in with a key on this node.", lastVote},
+		Gauge{"account_last_proposal_round", "The last round the account proposed a block with a key on this node.", lastProposal},
+	)
+}
+
+// Write renders every gauge derived from the StateModel to the writer.
+func Write(w io.Writer, state *algod.StateModel, t system.Time) error {
+	for _, gauge := range Gauges(state, t) {
+		if _, err := gauge.WriteTo(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Exporter serves the last rendered snapshot of the StateModel over HTTP.
+type Exporter struct {
+	mu   sync.RWMutex
+	body []byte
+	t    system.Time
+}
+
+// New creates an Exporter using the clock to compute key expiry.
+func New(t system.Time) *Exporter {
+	return &Exporter{t: t}
+}
+
+// Update renders a new snapshot of the StateModel.
+func (e *Exporter) Update(state *algod.StateModel) error {
+	var b bytes.Buffer
+	if err := Write(&b, state, e.t); err != nil {
+		return err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.body = b.Bytes()
+	return nil
+}
+
+// ServeHTTP responds with the last snapshot in the Prometheus text exposition format.
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	w.Header().Set("Content-Type", ContentType)
+	_, _ = w.Write(e.body)
+}
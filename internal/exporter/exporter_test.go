@@ -0,0 +1,103 @@
+package exporter
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/test/mock"
+)
+
+func getState() *algod.StateModel {
+	lastVote := 1200
+	expires := time.Time{}.Add(time.Hour)
+	participation := api.AccountParticipation{VoteLastValid: 30000}
+	return &algod.StateModel{
+		Status: algod.Status{
+			State:     algod.StableState,
+			Network:   "testnet-v1.0",
+			Version:   "v1",
+			LastRound: 1337,
+		},
+		Metrics: algod.Metrics{
+			RoundTime: 2500 * time.Millisecond,
+			TPS:       12.5,
+			RX:        1024,
+			TX:        2048,
+		},
+		Accounts: map[string]algod.Account{
+			"ABC": {
+				Address:           "ABC",
+				Status:            "Online",
+				Balance:           100,
+				IncentiveEligible: true,
+				Keys:              1,
+				Participation:     &participation,
+				Expires:           &expires,
+			},
+		},
+		ParticipationKeys: []api.ParticipationKey{
+			{Address: "ABC", Id: "123", LastVote: &lastVote},
+		},
+	}
+}
+
+func Test_Write(t *testing.T) {
+	var b bytes.Buffer
+	err := Write(&b, getState(), new(mock.Clock))
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := b.String()
+	expected := []string{
+		"# TYPE nodekit_node_up gauge",
+		"nodekit_node_up 1",
+		`nodekit_node_info{network="testnet-v1.0",version="v1"} 1`,
+		`nodekit_node_state{state="RUNNING"} 1`,
+		`nodekit_node_state{state="SYNCING"} 0`,
+		"nodekit_node_last_round 1337",
+		"nodekit_round_time_seconds 2.5",
+		"nodekit_transactions_per_second 12.5",
+		`nodekit_account_key_expires_seconds{address="ABC"} 3600`,
+		`nodekit_account_participating{address="ABC"} 1`,
+		`nodekit_account_incentive_eligible{address="ABC"} 1`,
+		`nodekit_account_balance_algos{address="ABC"} 100`,
+		`nodekit_account_last_vote_round{address="ABC"} 1200`,
+		`nodekit_account_last_proposal_round{address="ABC"} 0`,
+	}
+	for _, line := range expected {
+		if !strings.Contains(out, line+"\n") {
+			t.Errorf("expected output to contain %q", line)
+		}
+	}
+}
+
+func Test_EscapeLabel(t *testing.T) {
+	got := escapeLabel("a\"b\\c\nd")
+	if got != `a\"b\\c\nd` {
+		t.Errorf("unexpected escape, got %s", got)
+	}
+}
+
+func Test_ServeHTTP(t *testing.T) {
+	e := New(new(mock.Clock))
+	state := getState()
+	state.Status.State = algod.DownState
+	err := e.Update(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	if rec.Header().Get("Content-Type") != ContentType {
+		t.Errorf("unexpected content type %s", rec.Header().Get("Content-Type"))
+	}
+	if !strings.Contains(rec.Body.String(), "nodekit_node_up 0\n") {
+		t.Error("expected the node to be down")
+	}
+}
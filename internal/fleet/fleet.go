@@ -0,0 +1,91 @@
+package fleet
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Node is an algod instance managed by NodeKit.
+type Node struct {
+	// Name is shown in the fleet overview, defaults to the data directory name
+	Name string `yaml:"name" json:"name"`
+	// DataDir is the data directory of the node
+	DataDir string `yaml:"datadir" json:"datadir"`
+}
+
+// Config lists the nodes of a fleet.
+//
+//	nodes:
+//	  - name: mainnet
+//	    datadir: /var/lib/algorand
+//	  - name: testnet
+//	    datadir: /var/lib/algorand-testnet
+type Config struct {
+	Nodes []Node `yaml:"nodes" json:"nodes"`
+}
+
+// Load reads a fleet config file, JSON configs are read as YAML.
+func Load(path string) (Config, error) {
+	var config Config
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return config, err
+	}
+	err = yaml.Unmarshal(data, &config)
+	if err != nil {
+		return config, fmt.Errorf("invalid fleet config %s: %w", path, err)
+	}
+	for i, node := range config.Nodes {
+		if node.DataDir == "" {
+			return config, fmt.Errorf("invalid fleet config %s: node %d has no datadir", path, i)
+		}
+	}
+	config.Nodes = withNames(config.Nodes)
+	return config, nil
+}
+
+// FromDataDirs creates the nodes for a list of data directories.
+func FromDataDirs(dirs []string) []Node {
+	nodes := make([]Node, 0, len(dirs))
+	for _, dir := range dirs {
+		nodes = append(nodes, Node{DataDir: dir})
+	}
+	return withNames(nodes)
+}
+
+// Merge combines the nodes of the data directories with the nodes of the config, skipping duplicate data directories.
+func Merge(nodes ...[]Node) ([]Node, error) {
+	var merged []Node
+	seen := make(map[string]bool)
+	for _, list := range nodes {
+		for _, node := range list {
+			dir, err := filepath.Abs(node.DataDir)
+			if err != nil {
+				return nil, err
+			}
+			if seen[dir] {
+				continue
+			}
+			seen[dir] = true
+			merged = append(merged, node)
+		}
+	}
+	if len(merged) == 0 {
+		return nil, errors.New("no nodes configured")
+	}
+	return merged, nil
+}
+
+// withNames names every unnamed node after its data directory.
+func withNames(nodes []Node) []Node {
+	for i, node := range nodes {
+		if node.Name == "" {
+			nodes[i].Name = filepath.Base(filepath.Clean(node.DataDir))
+		}
+	}
+	return nodes
+}
@@ -0,0 +1,51 @@
+package fleet
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Load(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fleet.yaml")
+	err := os.WriteFile(path, []byte("nodes:\n  - name: mainnet\n    datadir: /var/lib/algorand\n  - datadir: /var/lib/testnet/\n"), 0644)
+	assert.Nil(t, err)
+
+	config, err := Load(path)
+	assert.Nil(t, err)
+	assert.Equal(t, []Node{
+		{Name: "mainnet", DataDir: "/var/lib/algorand"},
+		{Name: "testnet", DataDir: "/var/lib/testnet/"},
+	}, config.Nodes)
+
+	// JSON is valid YAML
+	err = os.WriteFile(path, []byte(`{"nodes":[{"datadir":"/var/lib/relay"}]}`), 0644)
+	assert.Nil(t, err)
+	config, err = Load(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "relay", config.Nodes[0].Name)
+
+	err = os.WriteFile(path, []byte("nodes:\n  - name: broken\n"), 0644)
+	assert.Nil(t, err)
+	_, err = Load(path)
+	assert.ErrorContains(t, err, "no datadir")
+
+	_, err = Load(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.NotNil(t, err)
+}
+
+func Test_Merge(t *testing.T) {
+	nodes, err := Merge(
+		FromDataDirs([]string{"/var/lib/algorand", "/var/lib/testnet"}),
+		[]Node{{Name: "main", DataDir: "/var/lib/algorand/"}, {Name: "relay", DataDir: "/var/lib/relay"}},
+	)
+	assert.Nil(t, err)
+	assert.Len(t, nodes, 3)
+	assert.Equal(t, "algorand", nodes[0].Name)
+	assert.Equal(t, "relay", nodes[2].Name)
+
+	_, err = Merge(nil)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,230 @@
+package history
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// DefaultFilename is the name of the history file in the NodeKit directory of the home directory.
+const DefaultFilename = "history.jsonl"
+
+// RecordType describes what a Record is about.
+type RecordType string
+
+const (
+	// RoundRecord holds the average round time at a round, recorded with every metrics update.
+	RoundRecord RecordType = "round"
+
+	// SyncRecord is recorded when the node changes state, for example from RUNNING to SYNCING.
+	SyncRecord RecordType = "sync"
+
+	// NodeDownRecord is recorded when the node stops responding.
+	NodeDownRecord RecordType = "node-down"
+
+	// NodeUpRecord is recorded when the node responds again.
+	NodeUpRecord RecordType = "node-up"
+
+	// AccountRecord is recorded when an account changes status, for example from Online to Offline.
+	AccountRecord RecordType = "account"
+
+	// KeyGeneratedRecord is recorded when a participation key is added to the node.
+	KeyGeneratedRecord RecordType = "key-generated"
+
+	// KeyDeletedRecord is recorded when a participation key is removed from the node.
+	KeyDeletedRecord RecordType = "key-deleted"
+
+	// KeyregRecord is recorded when an account registers a participation key online or goes offline.
+	KeyregRecord RecordType = "keyreg"
+)
+
+// Types are all the record types in the order they are documented.
+var Types = []RecordType{
+	RoundRecord, SyncRecord, NodeDownRecord, NodeUpRecord,
+	AccountRecord, KeyGeneratedRecord, KeyDeletedRecord, KeyregRecord,
+}
+
+// Record is a single observation of a node.
+type Record struct {
+	Time time.Time  `json:"time"`
+	Node string     `json:"node"`
+	Type RecordType `json:"type"`
+	// Round of the node when the record was made
+	Round uint64 `json:"round,omitempty"`
+	// Address of the account the record is about
+	Address string `json:"address,omitempty"`
+	// Key is the id of the participation key the record is about
+	Key string `json:"key,omitempty"`
+	// Value is the new state, status or round time of the record
+	Value string `json:"value,omitempty"`
+	// Message describes the record for humans
+	Message string `json:"message,omitempty"`
+}
+
+// Filter selects records, zero fields match every record.
+type Filter struct {
+	Node    string
+	Address string
+	Types   []RecordType
+	Since   time.Time
+	Until   time.Time
+	// Limit keeps the most recent records
+	Limit int
+}
+
+// Match checks if the record is selected by the filter.
+func (f Filter) Match(r Record) bool {
+	if f.Node != "" && r.Node != f.Node {
+		return false
+	}
+	if f.Address != "" && r.Address != f.Address {
+		return false
+	}
+	if len(f.Types) > 0 && !slices.Contains(f.Types, r.Type) {
+		return false
+	}
+	if !f.Since.IsZero() && r.Time.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && r.Time.After(f.Until) {
+		return false
+	}
+	return true
+}
+
+// Store is an append-only file of records, one JSON document per line.
+type Store struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+// DefaultPath returns the path of the history file in the home directory.
+func DefaultPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".nodekit", DefaultFilename), nil
+}
+
+// Open returns the store of the file, creating its directory when missing.
+func Open(path string) (*Store, error) {
+	if path == "" {
+		return nil, errors.New("history file path is empty")
+	}
+	err := os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return nil, err
+	}
+	return &Store{Path: path}, nil
+}
+
+// Append writes the records at the end of the file.
+func (s *Store) Append(records ...Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	file, err := os.OpenFile(s.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(file)
+	for _, record := range records {
+		if err = encoder.Encode(record); err != nil {
+			break
+		}
+	}
+	return errors.Join(err, file.Close())
+}
+
+// each calls fn for every record of the file in the order they were written.
+// A missing file has no records, lines that are not valid records are skipped.
+func (s *Store) each(fn func(line []byte, record Record)) error {
+	file, err := os.Open(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record Record
+		if json.Unmarshal(scanner.Bytes(), &record) != nil {
+			continue
+		}
+		fn(scanner.Bytes(), record)
+	}
+	return scanner.Err()
+}
+
+// Query returns the records selected by the filter, oldest first.
+func (s *Store) Query(f Filter) ([]Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var records []Record
+	err := s.each(func(_ []byte, record Record) {
+		if f.Match(record) {
+			records = append(records, record)
+		}
+	})
+	if f.Limit > 0 && len(records) > f.Limit {
+		records = records[len(records)-f.Limit:]
+	}
+	return records, err
+}
+
+// Prune removes the records older than the time and returns how many were removed.
+func (s *Store) Prune(before time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	temp, err := os.CreateTemp(filepath.Dir(s.Path), DefaultFilename+".*")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(temp.Name())
+
+	removed := 0
+	writer := bufio.NewWriter(temp)
+	err = s.each(func(line []byte, record Record) {
+		if record.Time.Before(before) {
+			removed++
+			return
+		}
+		_, _ = writer.Write(line)
+		_ = writer.WriteByte('\n')
+	})
+	err = errors.Join(err, writer.Flush(), temp.Close())
+	if err != nil {
+		return 0, err
+	}
+	return removed, os.Rename(temp.Name(), s.Path)
+}
+
+// WriteCSV writes the records with a header row.
+func WriteCSV(w io.Writer, records []Record) error {
+	writer := csv.NewWriter(w)
+	_ = writer.Write([]string{"time", "node", "type", "round", "address", "key", "value", "message"})
+	for _, r := range records {
+		round := ""
+		if r.Round != 0 {
+			round = strconv.FormatUint(r.Round, 10)
+		}
+		_ = writer.Write([]string{r.Time.Format(time.RFC3339), r.Node, string(r.Type), round, r.Address, r.Key, r.Value, r.Message})
+	}
+	writer.Flush()
+	return writer.Error()
+}
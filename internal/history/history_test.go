@@ -0,0 +1,189 @@
+package history
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+// testClock is a clock that can be moved forward.
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time { return c.now }
+
+func getStore(t *testing.T) *Store {
+	store, err := Open(filepath.Join(t.TempDir(), "nodekit", DefaultFilename))
+	assert.Nil(t, err)
+	return store
+}
+
+func Test_Store(t *testing.T) {
+	store := getStore(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// A missing file has no records
+	records, err := store.Query(Filter{})
+	assert.Nil(t, err)
+	assert.Empty(t, records)
+
+	err = store.Append(
+		Record{Time: start, Node: "a", Type: SyncRecord, Value: "SYNCING"},
+		Record{Time: start.Add(time.Hour), Node: "a", Type: SyncRecord, Value: "RUNNING"},
+		Record{Time: start.Add(2 * time.Hour), Node: "b", Type: AccountRecord, Address: "ABC", Value: "Offline"},
+		Record{Time: start.Add(3 * time.Hour), Node: "a", Type: RoundRecord, Round: 100, Value: "2.80"},
+	)
+	assert.Nil(t, err)
+
+	records, err = store.Query(Filter{Node: "a"})
+	assert.Nil(t, err)
+	assert.Len(t, records, 3)
+
+	records, err = store.Query(Filter{Types: []RecordType{SyncRecord}, Limit: 1})
+	assert.Nil(t, err)
+	assert.Len(t, records, 1)
+	assert.Equal(t, "RUNNING", records[0].Value)
+
+	records, err = store.Query(Filter{Address: "ABC"})
+	assert.Nil(t, err)
+	assert.Len(t, records, 1)
+
+	records, err = store.Query(Filter{Since: start.Add(time.Hour), Until: start.Add(2 * time.Hour)})
+	assert.Nil(t, err)
+	assert.Len(t, records, 2)
+
+	// Invalid lines are skipped
+	file, err := os.OpenFile(store.Path, os.O_APPEND|os.O_WRONLY, 0600)
+	assert.Nil(t, err)
+	_, _ = file.WriteString("not json\n")
+	_ = file.Close()
+
+	removed, err := store.Prune(start.Add(90 * time.Minute))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, removed)
+	records, err = store.Query(Filter{})
+	assert.Nil(t, err)
+	assert.Len(t, records, 2)
+
+	var out bytes.Buffer
+	err = WriteCSV(&out, records)
+	assert.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Len(t, lines, 3)
+	assert.Equal(t, "time,node,type,round,address,key,value,message", lines[0])
+	assert.Equal(t, "2024-01-01T03:00:00Z,a,round,100,,,2.80,", lines[2])
+
+	_, err = Open("")
+	assert.NotNil(t, err)
+}
+
+func Test_Recorder(t *testing.T) {
+	clock := &testClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	registered := mock.Keys[0].Key
+	state := &algod.StateModel{
+		Status:            algod.Status{State: algod.SyncingState, LastRound: 10},
+		ParticipationKeys: []api.ParticipationKey{mock.Keys[0]},
+		Accounts: map[string]algod.Account{
+			"ABC": {Address: "ABC", Status: "Offline"},
+		},
+	}
+	recorder := Recorder{Node: "local"}
+
+	// The first event records the state only
+	records := recorder.Observe(algod.Event{Type: algod.NewRoundEvent, State: state}, clock)
+	assert.Len(t, records, 1)
+	assert.Equal(t, SyncRecord, records[0].Type)
+	assert.Equal(t, "local", records[0].Node)
+
+	// Nothing changed
+	records = recorder.Observe(algod.Event{Type: algod.NewRoundEvent, State: state}, clock)
+	assert.Empty(t, records)
+
+	state.Status.State = algod.StableState
+	state.Metrics.RoundTime = 2800 * time.Millisecond
+	records = recorder.Observe(algod.Event{Type: algod.MetricsUpdatedEvent, State: state}, clock)
+	assert.Len(t, records, 2)
+	assert.Equal(t, RoundRecord, records[0].Type)
+	assert.Equal(t, "2.80", records[0].Value)
+	assert.Equal(t, "SYNCING -> RUNNING", records[1].Message)
+
+	// The key is registered online
+	state.Accounts["ABC"] = algod.Account{Address: "ABC", Status: "Online", Participation: &registered}
+	records = recorder.Observe(algod.Event{Type: algod.KeysChangedEvent, State: state}, clock)
+	assert.Len(t, records, 2)
+	for _, record := range records {
+		switch record.Type {
+		case AccountRecord:
+			assert.Equal(t, "Online", record.Value)
+		case KeyregRecord:
+			assert.Equal(t, "online", record.Value)
+			assert.Equal(t, "123", record.Key)
+		default:
+			t.Errorf("unexpected record %s", record.Type)
+		}
+	}
+
+	// The key is replaced
+	next := mock.Keys[0]
+	next.Id = "456"
+	state.ParticipationKeys = []api.ParticipationKey{next}
+	records = recorder.Observe(algod.Event{Type: algod.KeysChangedEvent, State: state}, clock)
+	assert.Len(t, records, 2)
+	types := []RecordType{records[0].Type, records[1].Type}
+	assert.ElementsMatch(t, []RecordType{KeyGeneratedRecord, KeyDeletedRecord}, types)
+
+	records = recorder.Observe(algod.Event{Type: algod.NodeDownEvent, State: state, Err: errors.New("timeout")}, clock)
+	assert.Len(t, records, 1)
+	assert.Equal(t, NodeDownRecord, records[0].Type)
+	assert.Equal(t, "timeout", records[0].Message)
+
+	// Events are appended to the store
+	recorder.Store = getStore(t)
+	events := make(chan algod.Event, 2)
+	events <- algod.Event{Type: algod.NodeUpEvent, State: state}
+	state.Accounts["ABC"] = algod.Account{Address: "ABC", Status: "Offline"}
+	events <- algod.Event{Type: algod.KeysChangedEvent, State: state}
+	close(events)
+	assert.Nil(t, recorder.Run(events, clock))
+	stored, err := recorder.Store.Query(Filter{Types: []RecordType{NodeUpRecord, KeyregRecord}})
+	assert.Nil(t, err)
+	assert.Len(t, stored, 2)
+}
+
+func Test_RecorderRunError(t *testing.T) {
+	clock := &testClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	state := &algod.StateModel{Status: algod.Status{State: algod.StableState, LastRound: 10}}
+	// The directory of the file is missing, like a full or read-only disk
+	dir := filepath.Join(t.TempDir(), "missing")
+	recorder := Recorder{Store: &Store{Path: filepath.Join(dir, "history.jsonl")}, Node: "local"}
+
+	events := make(chan algod.Event)
+	done := make(chan error)
+	go func() {
+		done <- recorder.Run(events, clock)
+	}()
+	events <- algod.Event{Type: algod.NodeDownEvent, State: state}
+	// Nothing changed, receiving it means the failed append is done
+	events <- algod.Event{Type: algod.NewRoundEvent, State: state}
+
+	// The recorder keeps going once the store works again
+	assert.Nil(t, os.MkdirAll(dir, 0700))
+	events <- algod.Event{Type: algod.NodeUpEvent, State: state}
+	close(events)
+	assert.NotNil(t, <-done)
+
+	stored, err := recorder.Store.Query(Filter{})
+	assert.Nil(t, err)
+	assert.Len(t, stored, 1)
+	assert.Equal(t, NodeUpRecord, stored[0].Type)
+}
@@ -0,0 +1,169 @@
+package history
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/system"
+	"github.com/charmbracelet/log"
+)
+
+// Recorder turns the events of a watched node into records by comparing each state with the previous one.
+type Recorder struct {
+	Store *Store
+	// Node identifies the watched node in the records, like its data directory or endpoint
+	Node string
+
+	// observed is set once the first state was seen, changes are only recorded after it
+	observed bool
+	state    algod.State
+	// accounts are the previous accounts by address
+	accounts map[string]algod.Account
+	// keys are the previous participation keys by id
+	keys map[string]api.ParticipationKey
+}
+
+// Observe returns the records for the changes since the previous event.
+// The first event only records the state of the node.
+func (r *Recorder) Observe(event algod.Event, t system.Time) []Record {
+	state := event.State
+	now := t.Now()
+	record := func(recordType RecordType) Record {
+		return Record{Time: now, Node: r.Node, Type: recordType, Round: state.Status.LastRound}
+	}
+
+	var records []Record
+	switch event.Type {
+	case algod.NodeDownEvent:
+		rec := record(NodeDownRecord)
+		if event.Err != nil {
+			rec.Message = event.Err.Error()
+		}
+		records = append(records, rec)
+	case algod.NodeUpEvent:
+		records = append(records, record(NodeUpRecord))
+	case algod.MetricsUpdatedEvent:
+		rec := record(RoundRecord)
+		rec.Value = strconv.FormatFloat(state.Metrics.RoundTime.Seconds(), 'f', 2, 64)
+		rec.Message = fmt.Sprintf("%.2f TPS", state.Metrics.TPS)
+		records = append(records, rec)
+	}
+
+	if !r.observed || state.Status.State != r.state {
+		rec := record(SyncRecord)
+		rec.Value = string(state.Status.State)
+		if r.observed {
+			rec.Message = fmt.Sprintf("%s -> %s", r.state, state.Status.State)
+		}
+		records = append(records, rec)
+	}
+
+	if r.observed {
+		records = append(records, r.diffKeys(state, record)...)
+		records = append(records, r.diffAccounts(state, record)...)
+	}
+
+	r.observed = true
+	r.state = state.Status.State
+	r.keys = make(map[string]api.ParticipationKey, len(state.ParticipationKeys))
+	for _, key := range state.ParticipationKeys {
+		r.keys[key.Id] = key
+	}
+	r.accounts = make(map[string]algod.Account, len(state.Accounts))
+	for address, acct := range state.Accounts {
+		r.accounts[address] = acct
+	}
+	return records
+}
+
+// diffKeys records the participation keys added to or removed from the node.
+func (r *Recorder) diffKeys(state *algod.StateModel, record func(RecordType) Record) []Record {
+	var records []Record
+	current := make(map[string]bool, len(state.ParticipationKeys))
+	for _, key := range state.ParticipationKeys {
+		current[key.Id] = true
+		if _, ok := r.keys[key.Id]; ok {
+			continue
+		}
+		rec := record(KeyGeneratedRecord)
+		rec.Address = key.Address
+		rec.Key = key.Id
+		rec.Message = fmt.Sprintf("valid from round %d to %d", key.Key.VoteFirstValid, key.Key.VoteLastValid)
+		records = append(records, rec)
+	}
+	for id, key := range r.keys {
+		if current[id] {
+			continue
+		}
+		rec := record(KeyDeletedRecord)
+		rec.Address = key.Address
+		rec.Key = id
+		records = append(records, rec)
+	}
+	return records
+}
+
+// diffAccounts records the status changes and key registrations of the accounts.
+func (r *Recorder) diffAccounts(state *algod.StateModel, record func(RecordType) Record) []Record {
+	var records []Record
+	for address, acct := range state.Accounts {
+		previous, ok := r.accounts[address]
+		if !ok {
+			continue
+		}
+		if acct.Status != previous.Status {
+			rec := record(AccountRecord)
+			rec.Address = address
+			rec.Value = acct.Status
+			rec.Message = fmt.Sprintf("%s -> %s", previous.Status, acct.Status)
+			records = append(records, rec)
+		}
+		if isSameRegistration(previous.Participation, acct.Participation) {
+			continue
+		}
+		rec := record(KeyregRecord)
+		rec.Address = address
+		if acct.Participation == nil {
+			rec.Value = "offline"
+		} else {
+			rec.Value = "online"
+			rec.Message = fmt.Sprintf("registered rounds %d to %d", acct.Participation.VoteFirstValid, acct.Participation.VoteLastValid)
+			if key := algod.FindRegisteredKey(state.ParticipationKeys, acct); key != nil {
+				rec.Key = key.Id
+			}
+		}
+		records = append(records, rec)
+	}
+	return records
+}
+
+// isSameRegistration checks if both are the same registered participation key, or both are unregistered.
+func isSameRegistration(a *api.AccountParticipation, b *api.AccountParticipation) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.VoteFirstValid == b.VoteFirstValid &&
+		a.VoteLastValid == b.VoteLastValid &&
+		bytes.Equal(a.VoteParticipationKey, b.VoteParticipationKey)
+}
+
+// Run records the events until the channel is closed and returns the first error of the store.
+// A failed append, like on a full disk, is logged and the next events are still recorded,
+// the error is only logged again after an append succeeded.
+func (r *Recorder) Run(events <-chan algod.Event, t system.Time) error {
+	var first, last error
+	for event := range events {
+		err := r.Store.Append(r.Observe(event, t)...)
+		if err != nil && last == nil {
+			log.Error("Failed to record the history", "path", r.Store.Path, "err", err)
+		}
+		if err != nil && first == nil {
+			first = err
+		}
+		last = err
+	}
+	return first
+}
@@ -0,0 +1,42 @@
+package system
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+)
+
+// SetFilePermissions matches a file's user and group
+// to its parent directory, and sets perms to 0o664,
+// or 0o775 for a directory.
+// Will only succeed if run as root (sudo)
+func SetFilePermissions(filePath string) error {
+	// Get the parent directory info
+	dirPath := filepath.Dir(filePath)
+	dirInfo, err := os.Stat(dirPath)
+	if err != nil {
+		return err
+	}
+
+	// Extract ownership info from the directory.
+	// This is specific to Unix-like systems (Linux and macOS)
+	stat, ok := dirInfo.Sys().(*syscall.Stat_t)
+	if !ok {
+		return fmt.Errorf("unable to get ownership details on file: %s", dirPath)
+	}
+
+	// Apply ownership permissions to file
+	uid := int(stat.Uid)
+	gid := int(stat.Gid)
+	if err := os.Chown(filePath, uid, gid); err != nil {
+		return err
+	}
+
+	// Also make sure group can write in the future
+	var mode os.FileMode = 0o664
+	if info, err := os.Stat(filePath); err == nil && info.IsDir() {
+		mode = 0o775
+	}
+	return os.Chmod(filePath, mode)
+}
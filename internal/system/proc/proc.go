@@ -0,0 +1,212 @@
+package proc
+
+import (
+	"bufio"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Root is the mount point of the Linux process filesystem.
+const Root = "/proc"
+
+// clockTicks is the unit of the CPU times of /proc/<pid>/stat, USER_HZ is 100 on every Linux architecture we support.
+const clockTicks = 100
+
+// listenState is the state of a listening socket in /proc/<pid>/net/tcp.
+const listenState = "0A"
+
+// Stats is the resource usage of a process.
+type Stats struct {
+	// RSS is the resident memory of the process in bytes
+	RSS uint64
+	// CPUTime is the user and system time used by the process since it started
+	CPUTime time.Duration
+	// StartedAt is when the process started
+	StartedAt time.Time
+	// OpenFiles is the number of open file descriptors, -1 when they can not be read
+	OpenFiles int
+	// Listening are the TCP addresses the process listens on, nil when its file descriptors can not be read
+	Listening []string
+}
+
+// CPU returns the CPU usage of the process as a percentage of one core, averaged since it started.
+func (s Stats) CPU(now time.Time) float64 {
+	elapsed := now.Sub(s.StartedAt)
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(s.CPUTime) / float64(elapsed) * 100
+}
+
+// Read returns the resource usage of a process from the process filesystem mounted at root.
+// The file descriptors of a process of another user can only be read as root,
+// the open files and listening addresses are unknown without them.
+func Read(root string, pid int) (Stats, error) {
+	stats := Stats{OpenFiles: -1}
+	dir := filepath.Join(root, strconv.Itoa(pid))
+
+	stat, err := os.ReadFile(filepath.Join(dir, "stat"))
+	if err != nil {
+		return stats, err
+	}
+	// The command name can hold spaces and parentheses, the fields start after the last one
+	end := strings.LastIndexByte(string(stat), ')')
+	if end < 0 {
+		return stats, fmt.Errorf("invalid stat file of process %d", pid)
+	}
+	fields := strings.Fields(string(stat)[end+1:])
+	if len(fields) < 20 {
+		return stats, fmt.Errorf("invalid stat file of process %d", pid)
+	}
+	// The fields are numbered from 1 in proc(5), the third is the first after the command
+	ticks := func(n int) uint64 {
+		v, _ := strconv.ParseUint(fields[n-3], 10, 64)
+		return v
+	}
+	stats.CPUTime = time.Duration(ticks(14)+ticks(15)) * time.Second / clockTicks
+	bootTime, err := readBootTime(root)
+	if err != nil {
+		return stats, err
+	}
+	stats.StartedAt = bootTime.Add(time.Duration(ticks(22)) * time.Second / clockTicks)
+
+	stats.RSS, err = readRSS(filepath.Join(dir, "status"))
+	if err != nil {
+		return stats, err
+	}
+
+	sockets, err := readSockets(filepath.Join(dir, "fd"))
+	if err != nil {
+		// Not our process, the remaining stats need root
+		return stats, nil
+	}
+	stats.OpenFiles = len(sockets.fds)
+	stats.Listening = []string{}
+	for _, name := range []string{"tcp", "tcp6"} {
+		listening, err := readListening(filepath.Join(dir, "net", name), sockets.inodes)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return stats, err
+		}
+		stats.Listening = append(stats.Listening, listening...)
+	}
+	sort.Strings(stats.Listening)
+	return stats, nil
+}
+
+// readBootTime returns when the system booted, the reference of the start time of processes.
+func readBootTime(root string) (time.Time, error) {
+	file, err := os.Open(filepath.Join(root, "stat"))
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if value, ok := strings.CutPrefix(scanner.Text(), "btime "); ok {
+			seconds, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+			if err != nil {
+				return time.Time{}, err
+			}
+			return time.Unix(seconds, 0), nil
+		}
+	}
+	return time.Time{}, errors.New("boot time not found")
+}
+
+// readRSS returns the resident memory in bytes from /proc/<pid>/status.
+func readRSS(path string) (uint64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if value, ok := strings.CutPrefix(scanner.Text(), "VmRSS:"); ok {
+			kb, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimSpace(value), " kB"), 10, 64)
+			return kb * 1024, err
+		}
+	}
+	// Kernel threads and zombies have no memory
+	return 0, nil
+}
+
+// fdSockets are the file descriptors of a process and the inodes of its sockets.
+type fdSockets struct {
+	fds    []os.DirEntry
+	inodes map[string]bool
+}
+
+// readSockets lists the file descriptors of a process, the links of sockets name their inode.
+func readSockets(dir string) (fdSockets, error) {
+	fds, err := os.ReadDir(dir)
+	if err != nil {
+		return fdSockets{}, err
+	}
+	sockets := fdSockets{fds: fds, inodes: make(map[string]bool)}
+	for _, fd := range fds {
+		target, err := os.Readlink(filepath.Join(dir, fd.Name()))
+		if err != nil {
+			continue
+		}
+		if inode, ok := strings.CutPrefix(target, "socket:["); ok {
+			sockets.inodes[strings.TrimSuffix(inode, "]")] = true
+		}
+	}
+	return sockets, nil
+}
+
+// readListening returns the listening addresses of a /proc/<pid>/net/tcp table owned by the socket inodes.
+func readListening(path string, inodes map[string]bool) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	var listening []string
+	scanner := bufio.NewScanner(file)
+	// Skip the header
+	scanner.Scan()
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 10 || fields[3] != listenState || !inodes[fields[9]] {
+			continue
+		}
+		address, err := parseAddress(fields[1])
+		if err != nil {
+			return nil, err
+		}
+		listening = append(listening, address)
+	}
+	return listening, scanner.Err()
+}
+
+// parseAddress converts an address of a /proc/net/tcp table, like 0100007F:1F90, to 127.0.0.1:8080.
+// The IP is made of 32-bit words in the byte order of the host, which is little-endian on the architectures we support.
+func parseAddress(s string) (string, error) {
+	hexIP, hexPort, ok := strings.Cut(s, ":")
+	if !ok {
+		return "", fmt.Errorf("invalid address %s", s)
+	}
+	raw, err := hex.DecodeString(hexIP)
+	if err != nil || (len(raw) != net.IPv4len && len(raw) != net.IPv6len) {
+		return "", fmt.Errorf("invalid address %s", s)
+	}
+	port, err := strconv.ParseUint(hexPort, 16, 16)
+	if err != nil {
+		return "", fmt.Errorf("invalid address %s", s)
+	}
+	ip := make(net.IP, len(raw))
+	for i := 0; i < len(raw); i += 4 {
+		ip[i], ip[i+1], ip[i+2], ip[i+3] = raw[i+3], raw[i+2], raw[i+1], raw[i]
+	}
+	return net.JoinHostPort(ip.String(), strconv.FormatUint(port, 10)), nil
+}
@@ -0,0 +1,82 @@
+package proc
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// writeProc creates a process filesystem holding the process 42 listening on 127.0.0.1:8080 and [::]:4160.
+func writeProc(t *testing.T) string {
+	root := t.TempDir()
+	dir := filepath.Join(root, "42")
+	assert.Nil(t, os.MkdirAll(filepath.Join(dir, "fd"), 0o755))
+	assert.Nil(t, os.MkdirAll(filepath.Join(dir, "net"), 0o755))
+	write := func(path string, content string) {
+		assert.Nil(t, os.WriteFile(filepath.Join(root, path), []byte(content), 0o644))
+	}
+	write("stat", "cpu  1 2 3 4\nbtime 1700000000\nprocesses 10\n")
+	write("42/stat", "42 (algod (v4)) S 1 42 42 0 -1 4194560 100 0 0 0 1500 500 0 0 20 0 12 0 360000 1000 2000\n")
+	write("42/status", "Name:\talgod\nVmPeak:\t  900000 kB\nVmRSS:\t  524288 kB\nThreads:\t12\n")
+	write("42/net/tcp", "  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode\n"+
+		"   0: 0100007F:1F90 00000000:0000 0A 00000000:00000000 00:00000000 00000000   999        0 1001 1 0\n"+
+		"   1: 0100007F:1F91 00000000:0000 0A 00000000:00000000 00:00000000 00000000   999        0 2002 1 0\n"+
+		"   2: 0100007F:1F90 0100007F:D431 01 00000000:00000000 00:00000000 00000000   999        0 1003 1 0\n")
+	write("42/net/tcp6", "  sl  local_address                         remote_address                        st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode\n"+
+		"   0: 00000000000000000000000000000000:1040 00000000000000000000000000000000:0000 0A 00000000:00000000 00:00000000 00000000   999        0 1002 1 0\n")
+	for fd, target := range map[string]string{"0": "/dev/null", "3": "socket:[1001]", "4": "socket:[1002]", "5": "socket:[1003]"} {
+		assert.Nil(t, os.Symlink(target, filepath.Join(dir, "fd", fd)))
+	}
+	return root
+}
+
+func Test_Read(t *testing.T) {
+	root := writeProc(t)
+	stats, err := Read(root, 42)
+	assert.Nil(t, err)
+	startedAt := time.Unix(1700000000+3600, 0)
+	assert.Equal(t, Stats{
+		RSS:       512 * 1024 * 1024,
+		CPUTime:   20 * time.Second,
+		StartedAt: startedAt,
+		OpenFiles: 4,
+		// The socket 2002 belongs to another process
+		Listening: []string{"127.0.0.1:8080", "[::]:4160"},
+	}, stats)
+	assert.Equal(t, 50.0, stats.CPU(startedAt.Add(40*time.Second)))
+
+	// The file descriptors of other users can not be read
+	assert.Nil(t, os.RemoveAll(filepath.Join(root, "42", "fd")))
+	stats, err = Read(root, 42)
+	assert.Nil(t, err)
+	assert.Equal(t, -1, stats.OpenFiles)
+	assert.Nil(t, stats.Listening)
+
+	_, err = Read(root, 43)
+	assert.NotNil(t, err)
+}
+
+func Test_ReadSelf(t *testing.T) {
+	if _, err := os.Stat(Root); err != nil {
+		t.Skip("no process filesystem")
+	}
+	stats, err := Read(Root, os.Getpid())
+	assert.Nil(t, err)
+	assert.Greater(t, stats.RSS, uint64(0))
+	assert.Greater(t, stats.OpenFiles, 0)
+	assert.WithinDuration(t, time.Now(), stats.StartedAt, time.Hour)
+}
+
+func Test_ParseAddress(t *testing.T) {
+	address, err := parseAddress("00000000:0FA0")
+	assert.Nil(t, err)
+	assert.Equal(t, "0.0.0.0:4000", address)
+	address, err = parseAddress("0000000000000000FFFF00000100007F:1F90")
+	assert.Nil(t, err)
+	assert.Equal(t, "127.0.0.1:8080", address)
+	_, err = parseAddress("XYZ:1F90")
+	assert.NotNil(t, err)
+}
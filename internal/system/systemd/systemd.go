@@ -0,0 +1,314 @@
+package systemd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	// destination is the bus name of the systemd manager
+	destination = "org.freedesktop.systemd1"
+	// managerPath is the object of the systemd manager
+	managerPath = dbus.ObjectPath("/org/freedesktop/systemd1")
+
+	managerInterface = "org.freedesktop.systemd1.Manager"
+	unitInterface    = "org.freedesktop.systemd1.Unit"
+	serviceInterface = "org.freedesktop.systemd1.Service"
+	jobInterface     = "org.freedesktop.systemd1.Job"
+)
+
+// DefaultTimeout is how long Start, Stop and Restart wait for their job to complete.
+const DefaultTimeout = 90 * time.Second
+
+// pollInterval is how often the job of an operation is checked.
+var pollInterval = 100 * time.Millisecond
+
+var (
+	// ErrNotFound is returned for a unit that systemd does not know.
+	ErrNotFound = errors.New("unit not found")
+	// ErrAccessDenied is returned when the caller is not allowed to manage the unit.
+	ErrAccessDenied = errors.New("access denied")
+	// ErrFailed is returned when a unit did not reach the requested state.
+	ErrFailed = errors.New("unit failed")
+	// ErrTimeout is returned when the job of an operation did not complete in time.
+	ErrTimeout = errors.New("timed out waiting for the job")
+)
+
+// Error is a failed operation of the systemd manager.
+type Error struct {
+	// Op is the operation, like start or stop
+	Op string
+	// Unit is the unit of the operation, empty for operations of the manager
+	Unit string
+	// Name is the D-Bus error name, empty when the error did not come from the bus
+	Name string
+	Err  error
+}
+
+func (e *Error) Error() string {
+	if e.Unit == "" {
+		return fmt.Sprintf("systemd %s: %s", e.Op, e.Err)
+	}
+	return fmt.Sprintf("systemd %s %s: %s", e.Op, e.Unit, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is matches the D-Bus errors of systemd with ErrNotFound and ErrAccessDenied.
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.Name == "org.freedesktop.systemd1.NoSuchUnit"
+	case ErrAccessDenied:
+		return e.Name == "org.freedesktop.DBus.Error.AccessDenied" ||
+			e.Name == "org.freedesktop.DBus.Error.InteractiveAuthorizationRequired"
+	}
+	return false
+}
+
+// wrap describes an error of an operation, keeping the name of D-Bus errors.
+func wrap(op string, unit string, err error) error {
+	if err == nil {
+		return nil
+	}
+	e := &Error{Op: op, Unit: unit, Err: err}
+	var busErr dbus.Error
+	var busErrPtr *dbus.Error
+	if errors.As(err, &busErr) {
+		e.Name = busErr.Name
+	} else if errors.As(err, &busErrPtr) {
+		e.Name = busErrPtr.Name
+	}
+	return e
+}
+
+// Bus is the part of a D-Bus connection used to talk to the systemd manager.
+type Bus interface {
+	// Call invokes a method of an object of the systemd manager and returns the body of the reply.
+	Call(path dbus.ObjectPath, method string, args ...any) ([]any, error)
+	// Properties returns the properties of an interface of an object of the systemd manager.
+	Properties(path dbus.ObjectPath, iface string) (map[string]any, error)
+	// Close closes the connection.
+	Close() error
+}
+
+// conn is a Bus backed by a D-Bus connection.
+type conn struct {
+	*dbus.Conn
+}
+
+func (c conn) Call(path dbus.ObjectPath, method string, args ...any) ([]any, error) {
+	// Lets polkit ask the user for a password when an agent is running
+	call := c.Object(destination, path).Call(method, dbus.FlagAllowInteractiveAuthorization, args...)
+	return call.Body, call.Err
+}
+
+func (c conn) Properties(path dbus.ObjectPath, iface string) (map[string]any, error) {
+	var variants map[string]dbus.Variant
+	err := c.Object(destination, path).Call("org.freedesktop.DBus.Properties.GetAll", 0, iface).Store(&variants)
+	if err != nil {
+		return nil, err
+	}
+	properties := make(map[string]any, len(variants))
+	for name, variant := range variants {
+		properties[name] = variant.Value()
+	}
+	return properties, nil
+}
+
+// Manager starts, stops and inspects the units of a systemd manager.
+type Manager struct {
+	bus Bus
+
+	// Timeout is how long Start, Stop and Restart wait for their job to complete
+	Timeout time.Duration
+}
+
+// New returns a Manager talking to systemd over a Bus.
+func New(bus Bus) *Manager {
+	return &Manager{bus: bus, Timeout: DefaultTimeout}
+}
+
+// ConnectSystem returns a Manager of the system services.
+func ConnectSystem() (*Manager, error) {
+	c, err := dbus.ConnectSystemBus()
+	if err != nil {
+		return nil, wrap("connect", "", err)
+	}
+	return New(conn{c}), nil
+}
+
+// ConnectUser returns a Manager of the services of the user, the systemd --user instance
+// listening on the session bus of the user.
+func ConnectUser() (*Manager, error) {
+	c, err := dbus.ConnectSessionBus()
+	if err != nil {
+		return nil, wrap("connect", "", err)
+	}
+	return New(conn{c}), nil
+}
+
+// Close closes the connection to the bus.
+func (m *Manager) Close() error {
+	return m.bus.Close()
+}
+
+// UnitStatus is the state of a unit and of the process of its service.
+type UnitStatus struct {
+	Name string
+	// LoadState is loaded when systemd read the unit file, not-found without one
+	LoadState string
+	// ActiveState is active, inactive, failed, activating, deactivating or reloading
+	ActiveState string
+	// SubState is the state specific to the unit type, like running or dead for a service
+	SubState string
+	// ActiveSince is when the unit entered the active state, zero when it is not active
+	ActiveSince time.Time
+
+	// MainPID is the process of the service, zero when it is not running
+	MainPID uint32
+	// Restarts is how many times systemd restarted the service automatically
+	Restarts uint32
+	// Result is why the service last stopped, like success, exit-code or signal
+	Result string
+	// ExitStatus is the exit code or signal of the last main process
+	ExitStatus int32
+}
+
+// IsActive reports whether the unit is running.
+func (s UnitStatus) IsActive() bool {
+	return s.ActiveState == "active" || s.ActiveState == "reloading"
+}
+
+// property returns a property of the expected type, or its zero value.
+func property[T any](properties map[string]any, name string) T {
+	value, _ := properties[name].(T)
+	return value
+}
+
+// loadUnit returns the object of a unit, loading it when it is not loaded.
+func (m *Manager) loadUnit(op string, unit string) (dbus.ObjectPath, error) {
+	body, err := m.bus.Call(managerPath, managerInterface+".LoadUnit", unit)
+	if err != nil {
+		return "", wrap(op, unit, err)
+	}
+	var path dbus.ObjectPath
+	if err := dbus.Store(body, &path); err != nil {
+		return "", wrap(op, unit, err)
+	}
+	return path, nil
+}
+
+// Status returns the state of a unit, unknown units have the not-found LoadState.
+func (m *Manager) Status(unit string) (UnitStatus, error) {
+	status := UnitStatus{Name: unit}
+	path, err := m.loadUnit("status", unit)
+	if err != nil {
+		return status, err
+	}
+	properties, err := m.bus.Properties(path, unitInterface)
+	if err != nil {
+		return status, wrap("status", unit, err)
+	}
+	status.LoadState = property[string](properties, "LoadState")
+	status.ActiveState = property[string](properties, "ActiveState")
+	status.SubState = property[string](properties, "SubState")
+	if usec := property[uint64](properties, "ActiveEnterTimestamp"); usec != 0 && status.IsActive() {
+		status.ActiveSince = time.UnixMicro(int64(usec))
+	}
+
+	// Only services have a process
+	if status.LoadState != "loaded" {
+		return status, nil
+	}
+	properties, err = m.bus.Properties(path, serviceInterface)
+	if err != nil {
+		return status, nil
+	}
+	status.MainPID = property[uint32](properties, "MainPID")
+	status.Restarts = property[uint32](properties, "NRestarts")
+	status.Result = property[string](properties, "Result")
+	status.ExitStatus = property[int32](properties, "ExecMainStatus")
+	return status, nil
+}
+
+// Exists reports whether systemd has a unit file for the unit.
+func (m *Manager) Exists(unit string) (bool, error) {
+	status, err := m.Status(unit)
+	if err != nil {
+		return false, err
+	}
+	return status.LoadState != "not-found", nil
+}
+
+// Start starts a unit and waits for it to be active.
+func (m *Manager) Start(unit string) error {
+	return m.run("start", "StartUnit", unit, true)
+}
+
+// Stop stops a unit and waits for it to be inactive.
+func (m *Manager) Stop(unit string) error {
+	return m.run("stop", "StopUnit", unit, false)
+}
+
+// Restart restarts a unit, starting it when it is not running, and waits for it to be active.
+func (m *Manager) Restart(unit string) error {
+	return m.run("restart", "RestartUnit", unit, true)
+}
+
+// Reload reloads the unit files, like systemctl daemon-reload.
+func (m *Manager) Reload() error {
+	_, err := m.bus.Call(managerPath, managerInterface+".Reload")
+	return wrap("reload", "", err)
+}
+
+// Enable enables a unit file, starting the unit with its target like systemctl enable.
+func (m *Manager) Enable(unit string) error {
+	_, err := m.bus.Call(managerPath, managerInterface+".EnableUnitFiles", []string{unit}, false, true)
+	return wrap("enable", unit, err)
+}
+
+// Disable disables a unit file, the unit is no longer started with its target.
+func (m *Manager) Disable(unit string) error {
+	_, err := m.bus.Call(managerPath, managerInterface+".DisableUnitFiles", []string{unit}, false)
+	return wrap("disable", unit, err)
+}
+
+// run queues the job of an operation on a unit and waits for it to complete.
+func (m *Manager) run(op string, method string, unit string, active bool) error {
+	body, err := m.bus.Call(managerPath, managerInterface+"."+method, unit, "replace")
+	if err != nil {
+		return wrap(op, unit, err)
+	}
+	var job dbus.ObjectPath
+	if err := dbus.Store(body, &job); err != nil {
+		return wrap(op, unit, err)
+	}
+
+	// The job object is removed once the job completed
+	deadline := time.Now().Add(m.Timeout)
+	for {
+		if _, err := m.bus.Properties(job, jobInterface); err != nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			return wrap(op, unit, ErrTimeout)
+		}
+		time.Sleep(pollInterval)
+	}
+
+	status, err := m.Status(unit)
+	if err != nil {
+		return err
+	}
+	if status.IsActive() != active {
+		return wrap(op, unit, fmt.Errorf("%w: %s (%s), result %s, exit status %d",
+			ErrFailed, status.ActiveState, status.SubState, status.Result, status.ExitStatus))
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package systemd
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeBus is a systemd manager holding a single service, jobs complete after a number of checks.
+type fakeBus struct {
+	unit              string
+	unitErr           error
+	unitProperties    map[string]any
+	serviceProperties map[string]any
+
+	// jobChecks is how many times a job is found before it completes
+	jobChecks int
+	// after is the state of the unit once the job completed
+	after map[string]any
+
+	calls []string
+}
+
+func newFakeBus() *fakeBus {
+	return &fakeBus{
+		unit: "algorand.service",
+		unitProperties: map[string]any{
+			"LoadState":   "loaded",
+			"ActiveState": "inactive",
+			"SubState":    "dead",
+		},
+		serviceProperties: map[string]any{
+			"MainPID":        uint32(0),
+			"NRestarts":      uint32(2),
+			"Result":         "success",
+			"ExecMainStatus": int32(0),
+		},
+	}
+}
+
+func (b *fakeBus) Call(path dbus.ObjectPath, method string, args ...any) ([]any, error) {
+	b.calls = append(b.calls, method)
+	switch method {
+	case managerInterface + ".LoadUnit":
+		if b.unitErr != nil {
+			return nil, b.unitErr
+		}
+		if args[0] != b.unit {
+			// systemd loads a placeholder for units without a file
+			return []any{dbus.ObjectPath("/org/freedesktop/systemd1/unit/missing")}, nil
+		}
+		return []any{dbus.ObjectPath("/org/freedesktop/systemd1/unit/algorand_2eservice")}, nil
+	case managerInterface + ".StartUnit", managerInterface + ".StopUnit", managerInterface + ".RestartUnit":
+		if b.unitErr != nil {
+			return nil, b.unitErr
+		}
+		return []any{dbus.ObjectPath("/org/freedesktop/systemd1/job/42")}, nil
+	case managerInterface + ".Reload":
+		return nil, nil
+	case managerInterface + ".EnableUnitFiles", managerInterface + ".DisableUnitFiles":
+		if files := args[0].([]string); files[0] != b.unit {
+			return nil, dbus.Error{Name: "org.freedesktop.systemd1.NoSuchUnit", Body: []any{"Unit file " + files[0] + " does not exist."}}
+		}
+		return nil, nil
+	}
+	return nil, dbus.Error{Name: "org.freedesktop.DBus.Error.UnknownMethod"}
+}
+
+func (b *fakeBus) Properties(path dbus.ObjectPath, iface string) (map[string]any, error) {
+	switch {
+	case iface == jobInterface:
+		if b.jobChecks > 0 {
+			b.jobChecks--
+			return map[string]any{"State": "running"}, nil
+		}
+		for name, value := range b.after {
+			b.unitProperties[name] = value
+		}
+		return nil, dbus.Error{Name: "org.freedesktop.DBus.Error.UnknownObject"}
+	case path == "/org/freedesktop/systemd1/unit/missing" && iface == unitInterface:
+		return map[string]any{"LoadState": "not-found", "ActiveState": "inactive", "SubState": "dead"}, nil
+	case iface == unitInterface:
+		return b.unitProperties, nil
+	case iface == serviceInterface:
+		return b.serviceProperties, nil
+	}
+	return nil, errors.New("unknown interface")
+}
+
+func (b *fakeBus) Close() error {
+	return nil
+}
+
+func Test_Status(t *testing.T) {
+	bus := newFakeBus()
+	since := time.UnixMicro(1700000000000000)
+	bus.unitProperties["ActiveState"] = "active"
+	bus.unitProperties["SubState"] = "running"
+	bus.unitProperties["ActiveEnterTimestamp"] = uint64(since.UnixMicro())
+	bus.serviceProperties["MainPID"] = uint32(1234)
+	m := New(bus)
+
+	status, err := m.Status("algorand.service")
+	assert.Nil(t, err)
+	assert.Equal(t, UnitStatus{
+		Name:        "algorand.service",
+		LoadState:   "loaded",
+		ActiveState: "active",
+		SubState:    "running",
+		ActiveSince: since,
+		MainPID:     1234,
+		Restarts:    2,
+		Result:      "success",
+	}, status)
+	assert.True(t, status.IsActive())
+
+	exists, err := m.Exists("algorand.service")
+	assert.Nil(t, err)
+	assert.True(t, exists)
+	exists, err = m.Exists("other.service")
+	assert.Nil(t, err)
+	assert.False(t, exists)
+}
+
+func Test_StartStop(t *testing.T) {
+	pollInterval = time.Millisecond
+	bus := newFakeBus()
+	bus.jobChecks = 3
+	bus.after = map[string]any{"ActiveState": "active", "SubState": "running"}
+	m := New(bus)
+
+	assert.Nil(t, m.Start("algorand.service"))
+	assert.Equal(t, 0, bus.jobChecks)
+	assert.Contains(t, bus.calls, managerInterface+".StartUnit")
+
+	bus.after = map[string]any{"ActiveState": "inactive", "SubState": "dead"}
+	assert.Nil(t, m.Stop("algorand.service"))
+
+	// A service exiting right away fails to start
+	bus.after = map[string]any{"ActiveState": "failed", "SubState": "failed"}
+	bus.serviceProperties["Result"] = "exit-code"
+	bus.serviceProperties["ExecMainStatus"] = int32(1)
+	err := m.Restart("algorand.service")
+	assert.ErrorIs(t, err, ErrFailed)
+	assert.EqualError(t, err, "systemd restart algorand.service: unit failed: failed (failed), result exit-code, exit status 1")
+
+	assert.Nil(t, m.Reload())
+}
+
+func Test_EnableDisable(t *testing.T) {
+	bus := newFakeBus()
+	m := New(bus)
+
+	assert.Nil(t, m.Enable("algorand.service"))
+	assert.Nil(t, m.Disable("algorand.service"))
+	assert.Equal(t, []string{managerInterface + ".EnableUnitFiles", managerInterface + ".DisableUnitFiles"}, bus.calls)
+
+	err := m.Enable("other.service")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.EqualError(t, err, "systemd enable other.service: Unit file other.service does not exist.")
+}
+
+func Test_Timeout(t *testing.T) {
+	pollInterval = time.Millisecond
+	bus := newFakeBus()
+	bus.jobChecks = 1000
+	m := New(bus)
+	m.Timeout = 10 * time.Millisecond
+
+	err := m.Start("algorand.service")
+	assert.ErrorIs(t, err, ErrTimeout)
+}
+
+func Test_Errors(t *testing.T) {
+	bus := newFakeBus()
+	m := New(bus)
+
+	bus.unitErr = dbus.Error{Name: "org.freedesktop.DBus.Error.InteractiveAuthorizationRequired", Body: []any{"Interactive authentication required."}}
+	err := m.Start("algorand.service")
+	assert.ErrorIs(t, err, ErrAccessDenied)
+	assert.False(t, errors.Is(err, ErrNotFound))
+	assert.EqualError(t, err, "systemd start algorand.service: Interactive authentication required.")
+
+	bus.unitErr = &dbus.Error{Name: "org.freedesktop.systemd1.NoSuchUnit"}
+	_, err = m.Status("algorand.service")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	var systemdErr *Error
+	assert.True(t, errors.As(err, &systemdErr))
+	assert.Equal(t, "status", systemdErr.Op)
+	assert.Equal(t, "org.freedesktop.systemd1.NoSuchUnit", systemdErr.Name)
+}
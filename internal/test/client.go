@@ -5,6 +5,7 @@ import (
 	"errors"
 	"github.com/algorandfoundation/nodekit/api"
 	"github.com/algorandfoundation/nodekit/internal/test/mock"
+	"io"
 	"net/http"
 )
 
@@ -341,3 +342,146 @@ func (c *Client) WaitForBlockWithResponse(ctx context.Context, round int, reqEdi
 	}
 	return &res, nil
 }
+
+func (c *Client) TransactionParamsWithResponse(ctx context.Context, reqEditors ...api.RequestEditorFn) (*api.TransactionParamsResponse, error) {
+	httpResponse := http.Response{StatusCode: 200}
+	data := new(struct {
+		ConsensusVersion string `json:"consensus-version"`
+		Fee              int    `json:"fee"`
+		GenesisHash      []byte `json:"genesis-hash"`
+		GenesisId        string `json:"genesis-id"`
+		LastRound        int    `json:"last-round"`
+		MinFee           int    `json:"min-fee"`
+	})
+	data.GenesisHash = mock.GenesisHash
+	data.GenesisId = "tui-net"
+	data.LastRound = 10
+	data.MinFee = 1000
+	res := api.TransactionParamsResponse{
+		Body:         nil,
+		HTTPResponse: &httpResponse,
+		JSON200:      data,
+	}
+	if c.Invalid {
+		res.HTTPResponse = &http.Response{StatusCode: 500}
+		res.JSON200 = nil
+	}
+	if c.Errors {
+		return &res, errors.New("test error")
+	}
+	return &res, nil
+}
+
+// RawTransactionWithBodyWithResponse accepts any transaction and returns a fixed transaction id.
+func (c *Client) RawTransactionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...api.RequestEditorFn) (*api.RawTransactionResponse, error) {
+	httpResponse := http.Response{StatusCode: 200}
+	res := api.RawTransactionResponse{
+		Body:         nil,
+		HTTPResponse: &httpResponse,
+		JSON200: &struct {
+			TxId string `json:"txId"`
+		}{TxId: "TXID"},
+	}
+	if c.Invalid {
+		res.HTTPResponse = &http.Response{StatusCode: 400}
+		res.JSON200 = nil
+		res.JSON400 = &api.ErrorResponse{Message: "transaction rejected"}
+	}
+	if c.Errors {
+		return &res, errors.New("test error")
+	}
+	return &res, nil
+}
+
+// PendingTransactionInformationWithResponse reports every transaction as confirmed in round 11.
+func (c *Client) PendingTransactionInformationWithResponse(ctx context.Context, txid string, params *api.PendingTransactionInformationParams, reqEditors ...api.RequestEditorFn) (*api.PendingTransactionInformationResponse, error) {
+	httpResponse := http.Response{StatusCode: 200}
+	round := 11
+	res := api.PendingTransactionInformationResponse{
+		Body:         nil,
+		HTTPResponse: &httpResponse,
+		JSON200:      &api.PendingTransactionResponse{ConfirmedRound: &round},
+	}
+	if c.Invalid {
+		res.HTTPResponse = &http.Response{StatusCode: 404}
+		res.JSON200 = nil
+	}
+	if c.Errors {
+		return &res, errors.New("test error")
+	}
+	return &res, nil
+}
+
+// AddParticipationKeyWithBodyWithResponse accepts any key file and returns the id of the first mock key.
+func (c *Client) AddParticipationKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...api.RequestEditorFn) (*api.AddParticipationKeyResponse, error) {
+	httpResponse := http.Response{StatusCode: 200}
+	res := api.AddParticipationKeyResponse{
+		Body:         nil,
+		HTTPResponse: &httpResponse,
+		JSON200: &struct {
+			PartId string `json:"partId"`
+		}{PartId: mock.Keys[0].Id},
+	}
+	if c.Invalid {
+		res.HTTPResponse = &http.Response{StatusCode: 400}
+		res.JSON200 = nil
+		res.JSON400 = &api.ErrorResponse{Message: "participation key already exists"}
+	}
+	if c.Errors {
+		return &res, errors.New("test error")
+	}
+	return &res, nil
+}
+
+// GetBlockWithResponse returns a block proposed by the mock account on even rounds, paying it one Algo.
+func (c *Client) GetBlockWithResponse(ctx context.Context, round int, params *api.GetBlockParams, reqEditors ...api.RequestEditorFn) (*api.GetBlockResponse, error) {
+	proposer := "OTHER"
+	if round%2 == 0 {
+		proposer = mock.ABCAccount.Address
+	}
+	httpResponse := http.Response{StatusCode: 200}
+	res := api.GetBlockResponse{
+		Body:         nil,
+		HTTPResponse: &httpResponse,
+		JSON200: &struct {
+			Block map[string]interface{}  `json:"block"`
+			Cert  *map[string]interface{} `json:"cert,omitempty"`
+		}{Block: map[string]interface{}{
+			"rnd": float64(round),
+			"ts":  float64(1700000000 + round*3),
+			"tc":  float64(round * 10),
+			"prp": proposer,
+			"pp":  float64(1000000),
+		}},
+	}
+	if c.Invalid {
+		res.HTTPResponse = &http.Response{StatusCode: 404}
+		res.JSON200 = nil
+	}
+	if c.Errors {
+		return &res, errors.New("test error")
+	}
+	return &res, nil
+}
+
+// GetSupplyWithResponse reports ten million Algo of online stake.
+func (c *Client) GetSupplyWithResponse(ctx context.Context, reqEditors ...api.RequestEditorFn) (*api.GetSupplyResponse, error) {
+	httpResponse := http.Response{StatusCode: 200}
+	res := api.GetSupplyResponse{
+		Body:         nil,
+		HTTPResponse: &httpResponse,
+		JSON200: &struct {
+			CurrentRound int `json:"current_round"`
+			OnlineMoney  int `json:"online-money"`
+			TotalMoney   int `json:"total-money"`
+		}{CurrentRound: 0, OnlineMoney: 10000000000000, TotalMoney: 10000000000000},
+	}
+	if c.Invalid {
+		res.HTTPResponse = &http.Response{StatusCode: 401}
+		res.JSON200 = nil
+	}
+	if c.Errors {
+		return &res, errors.New("test error")
+	}
+	return &res, nil
+}
@@ -8,6 +8,7 @@ var VoteKey = []byte("TESTKEY")
 var SelectionKey = []byte("TESTKEY")
 var StateProofKey = []byte("TESTKEY")
 var StateProofKeyTwo = []byte("TESTKEYTWO")
+var GenesisHash = make([]byte, 32)
 var Keys = []api.ParticipationKey{
 	{
 		Address:             "ABC",
@@ -0,0 +1,111 @@
+package watchlist
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultFilename is the name of the watch list in the NodeKit directory of the home directory.
+const DefaultFilename = "watch.yaml"
+
+// Account is an address watched by NodeKit without a participation key on the node.
+type Account struct {
+	Address string `yaml:"address" json:"address"`
+	// Label describes the account, like the node its key lives on
+	Label string `yaml:"label,omitempty" json:"label,omitempty"`
+}
+
+// Config lists the watched accounts.
+//
+//	accounts:
+//	  - address: AAAA...
+//	    label: backup node
+type Config struct {
+	Accounts []Account `yaml:"accounts" json:"accounts"`
+}
+
+// DefaultPath returns the path of the watch list in the home directory.
+func DefaultPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".nodekit", DefaultFilename), nil
+}
+
+// Load reads a watch list, a missing file has no accounts.
+func Load(path string) (Config, error) {
+	var config Config
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return config, nil
+	}
+	if err != nil {
+		return config, err
+	}
+	err = yaml.Unmarshal(data, &config)
+	if err != nil {
+		return config, fmt.Errorf("invalid watch list %s: %w", path, err)
+	}
+	for _, acct := range config.Accounts {
+		if !algod.ValidateAddress(acct.Address) {
+			return config, fmt.Errorf("invalid watch list %s: %q is not a valid address", path, acct.Address)
+		}
+	}
+	return config, nil
+}
+
+// Save writes the watch list, creating its directory when missing.
+func Save(path string, config Config) error {
+	err := os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// Add watches an address, updating the label of an address that is already watched.
+func (c *Config) Add(address string, label string) error {
+	if !algod.ValidateAddress(address) {
+		return fmt.Errorf("%q is not a valid address", address)
+	}
+	for i, acct := range c.Accounts {
+		if acct.Address == address {
+			c.Accounts[i].Label = label
+			return nil
+		}
+	}
+	c.Accounts = append(c.Accounts, Account{Address: address, Label: label})
+	return nil
+}
+
+// Remove stops watching an address and returns false when it was not watched.
+func (c *Config) Remove(address string) bool {
+	index := slices.IndexFunc(c.Accounts, func(acct Account) bool {
+		return acct.Address == address
+	})
+	if index < 0 {
+		return false
+	}
+	c.Accounts = slices.Delete(c.Accounts, index, index+1)
+	return true
+}
+
+// Addresses returns the watched addresses in the order they were added.
+func (c Config) Addresses() []string {
+	addresses := make([]string, 0, len(c.Accounts))
+	for _, acct := range c.Accounts {
+		addresses = append(addresses, acct.Address)
+	}
+	return addresses
+}
@@ -0,0 +1,50 @@
+package watchlist
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testAddress  = "QNZ7GONNHTNXFW56Y24CNJQEMYKZKKI566ASNSWPD24VSGKJWHGO6QOP7U"
+	otherAddress = "TUIDKH2C7MUHZDD77MAMUREJRKNK25SYXB7OAFA6JFBB24PEL5UX4S4GUU"
+)
+
+func Test_Config(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nodekit", DefaultFilename)
+
+	// A missing file has no accounts
+	config, err := Load(path)
+	assert.Nil(t, err)
+	assert.Empty(t, config.Accounts)
+
+	assert.Nil(t, config.Add(testAddress, "backup"))
+	assert.Nil(t, config.Add(otherAddress, ""))
+	assert.Nil(t, config.Add(testAddress, "relay"))
+	assert.NotNil(t, config.Add("ABC", ""))
+	assert.Equal(t, []string{testAddress, otherAddress}, config.Addresses())
+	assert.Equal(t, "relay", config.Accounts[0].Label)
+
+	assert.Nil(t, Save(path, config))
+	loaded, err := Load(path)
+	assert.Nil(t, err)
+	assert.Equal(t, config, loaded)
+
+	assert.True(t, loaded.Remove(testAddress))
+	assert.False(t, loaded.Remove(testAddress))
+	assert.Equal(t, []string{otherAddress}, loaded.Addresses())
+
+	// Invalid addresses are rejected
+	err = os.WriteFile(path, []byte("accounts:\n  - address: ABC\n"), 0600)
+	assert.Nil(t, err)
+	_, err = Load(path)
+	assert.NotNil(t, err)
+
+	err = os.WriteFile(path, []byte("accounts: ["), 0600)
+	assert.Nil(t, err)
+	_, err = Load(path)
+	assert.NotNil(t, err)
+}
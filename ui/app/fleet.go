@@ -0,0 +1,26 @@
+package app
+
+import (
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// NodeSelected is emitted when a node of the fleet is selected for the accounts and keys pages.
+type NodeSelected struct {
+	Name  string
+	State *algod.StateModel
+}
+
+// EmitNodeSelected creates a command that emits the selected node.
+func EmitNodeSelected(node NodeSelected) tea.Cmd {
+	return func() tea.Msg {
+		return node
+	}
+}
+
+// NodeError is an error from watching one node of the fleet.
+// It is only shown while the node is selected.
+type NodeError struct {
+	State *algod.StateModel
+	Err   error
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/algorandfoundation/nodekit/internal/algod"
 	"github.com/algorandfoundation/nodekit/internal/algod/participation"
 	"github.com/charmbracelet/lipgloss"
+	"os"
 	"time"
 
 	"github.com/algorandfoundation/nodekit/api"
@@ -75,6 +76,34 @@ func GenerateCmd(account string, rangeType participation.RangeType, duration int
 
 }
 
+// ImportCmd creates a command to import the participation key file at the path into the node.
+// The imported key is returned as a KeySelectedEvent so it can be registered next.
+func ImportCmd(path string, state *algod.StateModel) tea.Cmd {
+	return func() tea.Msg {
+		file, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		key, err := participation.Import(state.Context, state.Client, file, "")
+		if err != nil {
+			return err
+		}
+
+		return KeySelectedEvent{
+			Key: key,
+			Prefix: lipgloss.JoinVertical(
+				lipgloss.Left,
+				"Participation keys imported.",
+				"",
+				"Next step: register the participation keys with the network by signing a keyreg online transaction.",
+				"Press the R key to start this process.",
+				"",
+			),
+			Active: false,
+		}
+	}
+}
+
 // KeySelectedEvent represents an event triggered in the modal system.
 type KeySelectedEvent struct {
 
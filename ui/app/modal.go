@@ -26,11 +26,17 @@ const (
 	// GenerateModal represents a modal type used for generating or creating items or content within the application.
 	GenerateModal ModalType = "generate"
 
+	// ImportModal represents a modal type used for importing participation key files into the node.
+	ImportModal ModalType = "import"
+
 	// ExceptionModal represents a modal type used for displaying errors or exceptions within the application.
 	ExceptionModal ModalType = "exception"
 
 	// HybridModal represents a modal type used for displaying information to the user about new P2P Hybrid configurations.
 	HybridModal ModalType = "hybrid"
+
+	// AccountModal represents a modal type used for displaying the block proposal performance of an account.
+	AccountModal ModalType = "account"
 )
 
 // EmitShowModal creates a command to emit a modal message of the specified ModalType.
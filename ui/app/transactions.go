@@ -0,0 +1,36 @@
+package app
+
+import (
+	"github.com/algorandfoundation/nodekit/api"
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/signer"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TransactionConfirmed is emitted once a key registration signed by NodeKit is confirmed on-chain.
+type TransactionConfirmed struct {
+	// TxID of the confirmed transaction
+	TxID string
+	// Round the transaction was confirmed in
+	Round int
+}
+
+// SubmitKeyregCmd builds the key registration for the participation key, signs it and waits for its confirmation.
+// It emits a TransactionConfirmed or an error.
+func SubmitKeyregCmd(offline bool, part api.ParticipationKey, state *algod.StateModel, s signer.Signer) tea.Cmd {
+	return func() tea.Msg {
+		params, _, err := algod.GetSuggestedParams(state.Context, state.Client, algod.DefaultValidRounds)
+		if err != nil {
+			return err
+		}
+		txn, err := algod.MakeKeyRegTxn(part, state.Accounts[part.Address], offline, state.IncentivesDisabled, params)
+		if err != nil {
+			return err
+		}
+		txid, round, err := algod.SignAndSend(state.Context, state.Client, s, txn)
+		if err != nil {
+			return err
+		}
+		return TransactionConfirmed{TxID: txid, Round: round}
+	}
+}
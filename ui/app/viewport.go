@@ -9,11 +9,17 @@ type Page string
 
 const (
 
+	// FleetPage represents the page within the application used for listing the nodes of a fleet.
+	FleetPage Page = "fleet"
+
 	// AccountsPage represents the page within the application used for managing and displaying account information.
 	AccountsPage Page = "accounts"
 
 	// KeysPage represents the page within the application used for managing and displaying key-related information.
 	KeysPage Page = "keys"
+
+	// MetricsPage represents the page within the application used for charting the metrics history of the node.
+	MetricsPage Page = "metrics"
 )
 
 // EmitShowPage returns a command that emits a tea.Msg containing the given Page to be displayed in the application's viewport.
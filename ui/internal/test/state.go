@@ -45,7 +45,6 @@ func GetState(client api.ClientWithResponsesInterface) *algod.StateModel {
 		Accounts:          nil,
 		ParticipationKeys: mock2.Keys,
 		Admin:             false,
-		Watching:          false,
 		Client:            client,
 		HttpPkg:           new(api.HttpPkg),
 		Context:           context.Background(),
@@ -0,0 +1,162 @@
+package account
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/ui/app"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+type ViewModel struct {
+	Width   int
+	Height  int
+	Address string
+	State   *algod.StateModel
+}
+
+func New(state *algod.StateModel) ViewModel {
+	return ViewModel{
+		Width:  0,
+		Height: 0,
+		State:  state,
+	}
+}
+
+func (m ViewModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update processes a message and returns the updated model and command based on the received input.
+func (m ViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return m.HandleMessage(msg)
+}
+
+func (m ViewModel) HandleMessage(msg tea.Msg) (ViewModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case *algod.StateModel:
+		m.State = msg
+	case app.AccountSelected:
+		m.Address = msg.Address
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc", "enter":
+			return m, app.EmitCloseOverlay()
+		}
+	case tea.WindowSizeMsg:
+		m.Width = msg.Width
+		m.Height = msg.Height
+	}
+	return m, nil
+}
+
+// Title returns the fixed title string "Account Performance" for the ViewModel.
+func (m ViewModel) Title() string {
+	return "Account Performance"
+}
+
+func (m ViewModel) Controls() string {
+	return "| " + style.Red.Render("(esc) to close") + " |"
+}
+
+// BorderColor is green when the account proposes at least its share of blocks,
+// and yellow when it falls behind or is at risk of suspension.
+func (m ViewModel) BorderColor() string {
+	stats := m.State.Performance.Accounts[m.Address]
+	if absenteeism, ok := m.State.Absenteeism(m.Address); ok && absenteeism.AtRisk() {
+		return "3"
+	}
+	if stats.Expected >= 1 && stats.Ratio() < 0.5 {
+		return "3"
+	}
+	return "2"
+}
+
+// formatAlgo renders microalgos as Algo.
+func formatAlgo(microalgos int) string {
+	return fmt.Sprintf("%.6f ALGO", float64(microalgos)/1000000)
+}
+
+// Body displays the account with the proposal statistics collected while watching the node.
+func (m ViewModel) Body() string {
+	acct, ok := m.State.Accounts[m.Address]
+	if !ok {
+		return "No account selected"
+	}
+	performance := m.State.Performance
+	stats := performance.Accounts[m.Address]
+
+	status := acct.Status
+	if acct.WatchOnly {
+		status += " (watch-only, keys on another node)"
+	}
+	share := "N/A"
+	if performance.OnlineMoney > 0 {
+		share = fmt.Sprintf("%.4f%%", float64(acct.Balance)*1000000/float64(performance.OnlineMoney)*100)
+	}
+	scanned := "waiting for the next block"
+	if performance.Rounds > 0 {
+		scanned = fmt.Sprintf("%d (rounds %d to %d)", performance.Rounds, performance.StartRound, performance.LastRound)
+	}
+	ratio := "N/A"
+	if stats.Expected > 0 {
+		ratio = fmt.Sprintf("%.2f", stats.Ratio())
+	}
+	lastProposal := "N/A"
+	if stats.LastProposal > 0 {
+		lastProposal = fmt.Sprintf("round %d", stats.LastProposal)
+		if since := stats.SinceLastProposal(m.State.Status.LastRound, m.State.RoundTime().RoundTime); since > 0 {
+			lastProposal += fmt.Sprintf(" (%s ago)", since.Round(time.Second))
+		}
+	}
+
+	absence := "N/A"
+	if absenteeism, ok := m.State.Absenteeism(m.Address); ok {
+		absence = fmt.Sprintf("%.0f%% (%d of %d rounds unseen)", absenteeism.Risk()*100, absenteeism.Rounds, absenteeism.Threshold)
+		if absenteeism.Absent() {
+			absence = style.Red.Render("ABSENT " + absence)
+		} else if absenteeism.AtRisk() {
+			absence = style.Yellow.Render("AT RISK " + absence)
+		}
+	}
+
+	return ansi.Hardwrap(lipgloss.JoinVertical(lipgloss.Left,
+		"",
+		style.Cyan.Render("Account: ")+acct.Address,
+		style.Cyan.Render("Status: ")+status,
+		style.Cyan.Render("Balance: ")+fmt.Sprintf("%d ALGO", acct.Balance),
+		style.Cyan.Render("Incentive Eligible: ")+fmt.Sprintf("%t", acct.IncentiveEligible),
+		style.Cyan.Render("Online Stake Share: ")+share,
+		"",
+		style.Yellow.Render("Blocks Scanned: ")+scanned,
+		style.Yellow.Render("Blocks Proposed: ")+fmt.Sprintf("%d", stats.Proposals),
+		style.Yellow.Render("Expected Proposals: ")+fmt.Sprintf("%.2f", stats.Expected),
+		style.Yellow.Render("Proposed/Expected: ")+ratio,
+		style.Yellow.Render("Proposer Rewards: ")+formatAlgo(stats.Rewards),
+		style.Purple("Last Proposal: ")+lastProposal,
+		style.Purple("Absence Risk: ")+absence,
+		"",
+	), m.Width, true)
+}
+
+// View renders the ViewModel as a styled string, incorporating title, controls, and body content with dynamic borders.
+func (m ViewModel) View() string {
+	body := m.Body()
+	width := lipgloss.Width(body)
+	height := lipgloss.Height(body)
+	return style.WithNavigation(
+		m.Controls(),
+		style.WithTitle(
+			m.Title(),
+			// Apply the Borders with the Padding
+			style.ApplyBorder(width+2, height-4, m.BorderColor()).
+				PaddingRight(1).
+				PaddingLeft(1).
+				Render(body),
+		),
+	)
+}
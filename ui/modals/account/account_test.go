@@ -0,0 +1,99 @@
+package account
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/test/mock"
+	"github.com/algorandfoundation/nodekit/ui/app"
+	"github.com/algorandfoundation/nodekit/ui/internal/test"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func getState() *algod.StateModel {
+	state := test.GetState(nil)
+	state.Status.LastRound = 110
+	state.Performance = algod.Performance{
+		StartRound:  11,
+		LastRound:   110,
+		Rounds:      100,
+		OnlineMoney: 10000000000000,
+		Accounts: map[string]algod.AccountPerformance{
+			mock.Keys[0].Address: {Proposals: 1, Expected: 4, Rewards: 1500000, LastProposal: 100},
+		},
+	}
+	acct := state.Accounts[mock.Keys[0].Address]
+	acct.Status = "Online"
+	acct.Balance = 400000
+	acct.LastProposed = 100
+	state.Accounts[mock.Keys[0].Address] = acct
+	return state
+}
+
+func Test_New(t *testing.T) {
+	m := New(getState())
+	m.Address = mock.Keys[0].Address
+	// One proposal out of four expected is behind
+	if m.BorderColor() != "3" {
+		t.Error("State is not correct, border should be 3")
+	}
+	m.State.Performance.Accounts[m.Address] = algod.AccountPerformance{Proposals: 4, Expected: 4}
+	if m.BorderColor() != "2" {
+		t.Error("State is not correct, border should be 2")
+	}
+}
+
+func Test_Snapshot(t *testing.T) {
+	t.Run("Visible", func(t *testing.T) {
+		model := New(getState())
+		model.Address = mock.Keys[0].Address
+		got := ansi.Strip(model.View())
+		golden.RequireEqual(t, []byte(got))
+	})
+	t.Run("NotScanned", func(t *testing.T) {
+		model := New(test.GetState(nil))
+		model.Address = mock.Keys[0].Address
+		got := ansi.Strip(model.View())
+		golden.RequireEqual(t, []byte(got))
+	})
+	t.Run("NoAccount", func(t *testing.T) {
+		model := New(test.GetState(nil))
+		got := ansi.Strip(model.View())
+		golden.RequireEqual(t, []byte(got))
+	})
+}
+
+func Test_Messages(t *testing.T) {
+	state := getState()
+	m := New(state)
+
+	tm := teatest.NewTestModel(
+		t, m,
+		teatest.WithInitialTermSize(80, 40),
+	)
+	tm.Send(app.AccountSelected(&algod.Account{Address: mock.Keys[0].Address}))
+	tm.Send(state)
+
+	teatest.WaitFor(
+		t, tm.Output(),
+		func(bts []byte) bool {
+			return bytes.Contains(bts, []byte("Proposer Rewards: 1.500000 ALGO"))
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*3),
+	)
+
+	tm.Send(tea.KeyMsg{
+		Type:  tea.KeyRunes,
+		Runes: []rune("esc"),
+	})
+
+	tm.Send(tea.QuitMsg{})
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
@@ -1,8 +1,12 @@
 package info
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/algorandfoundation/nodekit/api"
 	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/system"
 	"github.com/algorandfoundation/nodekit/ui/app"
 	"github.com/algorandfoundation/nodekit/ui/style"
 	"github.com/algorandfoundation/nodekit/ui/utils"
@@ -107,6 +111,7 @@ func (m ViewModel) Body() string {
 	voteFirstValid := style.Purple("Vote First Valid: ") + utils.IntToStr(m.Participation.Key.VoteFirstValid)
 	voteLastValid := style.Purple("Vote Last Valid: ") + utils.IntToStr(m.Participation.Key.VoteLastValid)
 	voteKeyDilution := style.Purple("Vote Key Dilution: ") + utils.IntToStr(m.Participation.Key.VoteKeyDilution)
+	expires := style.Purple("Expires: ") + m.expires()
 
 	prefix := ""
 	if m.Suspended {
@@ -127,10 +132,27 @@ func (m ViewModel) Body() string {
 		voteFirstValid,
 		voteLastValid,
 		voteKeyDilution,
+		expires,
 		"",
 	), m.Width, true)
 }
 
+// expires estimates when the key reaches its last valid round, with the confidence range of the round time.
+func (m ViewModel) expires() string {
+	if m.State == nil {
+		return "N/A"
+	}
+	expiry, ok := m.State.RoundTime().Expires(new(system.Clock), int(m.State.Status.LastRound), m.Participation.Key.VoteLastValid)
+	if !ok {
+		return "N/A"
+	}
+	if !expiry.Time.After(time.Now()) {
+		return "EXPIRED"
+	}
+	return fmt.Sprintf("%s (between %s and %s)",
+		expiry.Time.Format(time.RFC822), expiry.Earliest.Format(time.RFC822), expiry.Latest.Format(time.RFC822))
+}
+
 // View renders the ViewModel as a styled string, incorporating title, controls, and body content with dynamic borders.
 func (m ViewModel) View() string {
 	body := m.Body()
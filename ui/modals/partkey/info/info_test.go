@@ -8,6 +8,7 @@ import (
 	"github.com/charmbracelet/x/ansi"
 	"github.com/charmbracelet/x/exp/golden"
 	"github.com/charmbracelet/x/exp/teatest"
+	"strings"
 	"testing"
 	"time"
 )
@@ -25,6 +26,13 @@ func Test_New(t *testing.T) {
 	if m.Navigation() != "( take (o)ffline )" {
 		t.Error("Controls are not correct")
 	}
+	if m.expires() != "N/A" {
+		t.Error("Expiry should be unknown before the first round")
+	}
+	m.State.Status.LastRound = 100
+	if !strings.Contains(m.expires(), "(between ") {
+		t.Errorf("Expiry should have a range, got %s", m.expires())
+	}
 }
 func Test_Snapshot(t *testing.T) {
 	// TODO: Suspended, and Corrupt Key
@@ -3,6 +3,8 @@ package transaction
 import (
 	"encoding/base64"
 	"github.com/algorandfoundation/nodekit/internal/algod/participation"
+	"github.com/algorandfoundation/nodekit/internal/algod/signer"
+	"github.com/charmbracelet/bubbles/textinput"
 
 	"github.com/algorand/go-algorand-sdk/v2/types"
 	"github.com/algorandfoundation/algourl/encoder"
@@ -28,10 +30,45 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (*ViewModel, tea.Cmd) {
 	// When the link response comes back, display this modal with the updated state
 	case participation.ShortLinkResponse:
 		m.Link = &msg
+		m.SetStep(LinkStep)
 		// Ensure the transaction modal is showing
 		return &m, app.EmitShowModal(app.TransactionModal)
+	// The locally signed transaction was confirmed
+	case app.TransactionConfirmed:
+		m.SetStep(ConfirmedStep)
+		m.Confirmed = &msg
+		return &m, nil
+	// Submitting failed, the error is shown by the exception modal
+	case error:
+		if m.Step == SubmittingStep {
+			m.SetStep(LinkStep)
+		}
 	// Handle keystroke interactions like cancel
 	case tea.KeyMsg:
+		switch m.Step {
+		case MnemonicStep:
+			return m.handleMnemonicKey(msg)
+		case ConfirmStep:
+			switch msg.String() {
+			case "y", "enter":
+				m.SetStep(SubmittingStep)
+				return &m, app.SubmitKeyregCmd(m.OfflineControls, *m.Participation, m.State, m.Signer)
+			case "n", "esc":
+				m.SetStep(LinkStep)
+				return &m, nil
+			}
+			return &m, nil
+		case SubmittingStep:
+			// Wait for the confirmation
+			return &m, nil
+		case ConfirmedStep:
+			if msg.String() == "esc" {
+				m.SetStep(LinkStep)
+				return &m, app.EmitCancelOverlay()
+			}
+			return &m, nil
+		}
+
 		switch msg.String() {
 		case "esc":
 			return &m, app.EmitCancelOverlay()
@@ -41,6 +78,11 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (*ViewModel, tea.Cmd) {
 				m.UpdateState()
 				return &m, nil
 			}
+		case "l":
+			if m.Participation != nil {
+				m.SetStep(MnemonicStep)
+				return &m, textinput.Blink
+			}
 		}
 
 	// Handle View Size changes
@@ -52,6 +94,31 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (*ViewModel, tea.Cmd) {
 	return &m, cmd
 }
 
+// handleMnemonicKey updates the mnemonic input and creates the signer when it is submitted.
+func (m ViewModel) handleMnemonicKey(msg tea.KeyMsg) (*ViewModel, tea.Cmd) {
+	var cmd tea.Cmd
+	switch msg.String() {
+	case "esc":
+		m.SetStep(LinkStep)
+		return &m, nil
+	case "enter":
+		s, err := signer.NewMnemonicSigner(m.MnemonicInput.Value())
+		if err != nil {
+			m.MnemonicInputError = "Error: invalid mnemonic"
+			return &m, nil
+		}
+		if s.Address() != m.Participation.Address {
+			m.MnemonicInputError = "Error: the mnemonic is for another account"
+			return &m, nil
+		}
+		m.Signer = s
+		m.SetStep(ConfirmStep)
+		return &m, nil
+	}
+	m.MnemonicInput, cmd = m.MnemonicInput.Update(msg)
+	return &m, cmd
+}
+
 func (m ViewModel) Account() *algod.Account {
 	if m.Participation == nil || m.State == nil || m.State.Accounts == nil {
 		return nil
@@ -70,7 +137,7 @@ func (m ViewModel) ShouldAddIncentivesFee() bool {
 	// 1) incentives allowed by user: command line flag to disable incentives has not been passed
 	// 2) online keyreg
 	// 3) account is not already incentives eligible
-	return m.State != nil && !m.State.IncentivesDisabled && !m.OfflineControls && m.Account() != nil && !m.Account().IncentiveEligible
+	return m.State != nil && !m.OfflineControls && m.Account() != nil && m.Account().ShouldAddIncentivesFee(m.State.IncentivesDisabled)
 }
 
 func (m *ViewModel) UpdateState() {
@@ -85,7 +152,7 @@ func (m *ViewModel) UpdateState() {
 
 	var fee *uint64
 	if m.ShouldAddIncentivesFee() {
-		feeInst := uint64(2000000)
+		feeInst := uint64(participation.IncentiveEligibilityFee)
 		fee = &feeInst
 	}
 
@@ -6,6 +6,25 @@ import (
 	"github.com/algorandfoundation/nodekit/api"
 	"github.com/algorandfoundation/nodekit/internal/algod"
 	"github.com/algorandfoundation/nodekit/internal/algod/participation"
+	"github.com/algorandfoundation/nodekit/internal/algod/signer"
+	"github.com/algorandfoundation/nodekit/ui/app"
+	"github.com/charmbracelet/bubbles/textinput"
+)
+
+// Step is the current step of signing the transaction locally.
+type Step string
+
+const (
+	// LinkStep shows the link or QR code for an external wallet
+	LinkStep Step = "link"
+	// MnemonicStep asks for the mnemonic of the account
+	MnemonicStep Step = "mnemonic"
+	// ConfirmStep asks to confirm the transaction before submitting it
+	ConfirmStep Step = "confirm"
+	// SubmittingStep waits for the transaction to be confirmed
+	SubmittingStep Step = "submitting"
+	// ConfirmedStep shows the confirmed transaction
+	ConfirmedStep Step = "confirmed"
 )
 
 type ViewModel struct {
@@ -28,6 +47,41 @@ type ViewModel struct {
 
 	// QR Code
 	ATxn *encoder.AUrlTxn
+
+	// Step of signing the transaction locally
+	Step Step
+	// MnemonicInput receives the account mnemonic without echoing it
+	MnemonicInput      textinput.Model
+	MnemonicInputError string
+	// Signer of the account once the mnemonic is entered, it is dropped after submitting
+	Signer signer.Signer
+	// Confirmed is the locally signed transaction once it is confirmed
+	Confirmed *app.TransactionConfirmed
+}
+
+// SetStep changes the local signing step, the mnemonic and signer are cleared when going back to the link.
+func (m *ViewModel) SetStep(step Step) {
+	m.Step = step
+	switch step {
+	case LinkStep:
+		m.Signer = nil
+		m.Confirmed = nil
+		m.MnemonicInput.Reset()
+		m.MnemonicInput.Blur()
+		m.MnemonicInputError = ""
+	case MnemonicStep:
+		m.MnemonicInput.Reset()
+		m.MnemonicInput.Focus()
+		m.MnemonicInputError = ""
+	case ConfirmStep, SubmittingStep:
+		m.MnemonicInput.Reset()
+		m.MnemonicInput.Blur()
+	}
+}
+
+// IsTyping checks if the modal is receiving text input.
+func (m ViewModel) IsTyping() bool {
+	return m.Step == MnemonicStep
 }
 
 func (m ViewModel) FormatedAddress() string {
@@ -40,9 +94,15 @@ func (m ViewModel) IsQREnabled() bool {
 
 // New creates and instance of the ViewModel with a default controls.Model
 func New(state *algod.StateModel) *ViewModel {
+	input := textinput.New()
+	input.Placeholder = "25-word mnemonic"
+	input.EchoMode = textinput.EchoPassword
+	input.EchoCharacter = '*'
 	return &ViewModel{
-		State:    state,
-		ShowLink: true,
-		ATxn:     nil,
+		State:         state,
+		ShowLink:      true,
+		ATxn:          nil,
+		Step:          LinkStep,
+		MnemonicInput: input,
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"github.com/algorandfoundation/nodekit/internal/algod/participation"
 	"github.com/algorandfoundation/nodekit/internal/test/mock"
+	"github.com/algorandfoundation/nodekit/ui/app"
 	"github.com/algorandfoundation/nodekit/ui/internal/test"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/x/ansi"
@@ -72,6 +73,21 @@ func Test_Snapshot(t *testing.T) {
 		got := ansi.Strip(model.View())
 		golden.RequireEqual(t, []byte(got))
 	})
+	t.Run("Mnemonic", func(t *testing.T) {
+		model := New(test.GetState(nil))
+		model.Participation = &mock.Keys[0]
+		model.SetStep(MnemonicStep)
+		model.MnemonicInputError = "Error: invalid mnemonic"
+		got := ansi.Strip(model.View())
+		golden.RequireEqual(t, []byte(got))
+	})
+	t.Run("Confirmed", func(t *testing.T) {
+		model := New(test.GetState(nil))
+		model.Participation = &mock.Keys[0]
+		model, _ = model.HandleMessage(app.TransactionConfirmed{TxID: "TXID", Round: 11})
+		got := ansi.Strip(model.View())
+		golden.RequireEqual(t, []byte(got))
+	})
 	t.Run("NoKey", func(t *testing.T) {
 		model := New(test.GetState(nil))
 		got := ansi.Strip(model.View())
@@ -112,3 +128,30 @@ func Test_Messages(t *testing.T) {
 
 	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
 }
+
+func Test_LocalSign(t *testing.T) {
+	model := New(test.GetState(nil))
+	model.Participation = &mock.Keys[0]
+
+	model, _ = model.HandleMessage(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("l")})
+	if model.Step != MnemonicStep || !model.IsTyping() {
+		t.Fatalf("expected the mnemonic step, got %s", model.Step)
+	}
+
+	model, _ = model.HandleMessage(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("bad")})
+	model, _ = model.HandleMessage(tea.KeyMsg{Type: tea.KeyEnter})
+	if model.Step != MnemonicStep || model.MnemonicInputError == "" {
+		t.Error("expected an invalid mnemonic error")
+	}
+
+	model, _ = model.HandleMessage(tea.KeyMsg{Type: tea.KeyEsc})
+	if model.Step != LinkStep || model.MnemonicInput.Value() != "" {
+		t.Error("expected the link step with a cleared input")
+	}
+
+	model.SetStep(ConfirmStep)
+	model, _ = model.HandleMessage(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	if model.Step != LinkStep {
+		t.Error("expected to go back to the link step")
+	}
+}
@@ -33,20 +33,86 @@ func (m ViewModel) Controls() string {
 }
 func (m ViewModel) Navigation() string {
 	escLegend := style.Red.Render("(esc) go back")
+	switch m.Step {
+	case MnemonicStep:
+		return "( " + style.Green.Render("(enter) continue") + " | " + escLegend + " )"
+	case ConfirmStep:
+		return "( " + style.Green.Render("(y)es submit") + " | " + style.Red.Render("(n)o go back") + " )"
+	case SubmittingStep:
+		return ""
+	case ConfirmedStep:
+		return "( " + escLegend + " )"
+	}
+	signLegend := style.Green.Render("(l)ocal sign")
 	if m.IsQREnabled() {
 		otherView := "link"
 		if m.ShowLink {
 			otherView = "QR"
 		}
-		return "( " + style.Yellow.Render("(s)how "+otherView) + " | " + escLegend + " )"
+		return "( " + style.Yellow.Render("(s)how "+otherView) + " | " + signLegend + " | " + escLegend + " )"
 	}
-	return "( " + escLegend + " )"
+	return "( " + signLegend + " | " + escLegend + " )"
+}
+
+// LocalBody renders the steps of signing the transaction locally.
+func (m ViewModel) LocalBody() string {
+	adj := "online"
+	if m.OfflineControls {
+		adj = "offline"
+	}
+	switch m.Step {
+	case MnemonicStep:
+		render := lipgloss.JoinVertical(
+			lipgloss.Left,
+			"Enter the mnemonic of your account to sign the transaction",
+			"",
+			m.MnemonicInput.View(),
+		)
+		if m.MnemonicInputError != "" {
+			render = lipgloss.JoinVertical(lipgloss.Left, render, "", style.Red.Render(m.MnemonicInputError))
+		}
+		return lipgloss.JoinVertical(
+			lipgloss.Left,
+			render,
+			"",
+			style.Yellow.Render("The mnemonic is only used to sign this transaction and is never stored."),
+		)
+	case ConfirmStep:
+		fee := "the minimum fee"
+		if m.ShouldAddIncentivesFee() {
+			fee = "a 2 ALGO fee (opting in to rewards)"
+		}
+		return lipgloss.JoinVertical(
+			lipgloss.Center,
+			fmt.Sprintf("Register your account as %s with %s?", adj, fee),
+			"",
+			"The transaction is signed locally and submitted to your node.",
+		)
+	case SubmittingStep:
+		return "Submitting the transaction and waiting for confirmation..."
+	case ConfirmedStep:
+		if m.Confirmed == nil {
+			return "Transaction confirmed"
+		}
+		return lipgloss.JoinVertical(
+			lipgloss.Center,
+			fmt.Sprintf("Transaction confirmed in round %d", m.Confirmed.Round),
+			"",
+			m.Confirmed.TxID,
+			"",
+			fmt.Sprintf("Waiting for the node to see the %s registration...", adj),
+		)
+	}
+	return ""
 }
 
 func (m ViewModel) Body() string {
 	if m.Participation == nil {
 		return "No key selected"
 	}
+	if m.Step != LinkStep && m.Step != "" {
+		return m.LocalBody()
+	}
 	if m.ATxn == nil || m.Link == nil {
 		return "Loading..."
 	}
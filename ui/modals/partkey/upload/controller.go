@@ -0,0 +1,64 @@
+package upload
+
+import (
+	"os"
+	"strings"
+
+	"github.com/algorandfoundation/nodekit/ui/app"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Init initializes the ViewModel with the blinking cursor of the path input.
+func (m ViewModel) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// Update processes incoming messages, updating the ViewModel state and returning a new model and command.
+func (m ViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return m.HandleMessage(msg)
+}
+
+// HandleMessage processes incoming messages, updates the ViewModel state, and returns an updated model and command.
+func (m ViewModel) HandleMessage(msg tea.Msg) (ViewModel, tea.Cmd) {
+	var cmd tea.Cmd
+	switch msg := msg.(type) {
+	// The import failed, the error is shown by the exception modal
+	case error:
+		if m.Step == WaitingStep {
+			m.Step = PathStep
+		}
+		return m, nil
+	case app.KeySelectedEvent:
+		if m.Step == WaitingStep {
+			m.Reset()
+		}
+		return m, nil
+	case tea.WindowSizeMsg:
+		m.Width = msg.Width
+		m.Height = msg.Height
+	case tea.KeyMsg:
+		if m.Step == WaitingStep {
+			return m, nil
+		}
+		switch msg.String() {
+		case "esc":
+			return m, app.EmitCloseOverlay()
+		case "enter":
+			path := strings.TrimSpace(m.PathInput.Value())
+			info, err := os.Stat(path)
+			if err != nil || info.IsDir() {
+				m.PathInputError = "Error: file not found"
+				return m, nil
+			}
+			m.PathInputError = ""
+			m.Step = WaitingStep
+			return m, app.ImportCmd(path, m.State)
+		}
+	}
+
+	if m.Step == PathStep {
+		m.PathInput, cmd = m.PathInput.Update(msg)
+	}
+	return m, cmd
+}
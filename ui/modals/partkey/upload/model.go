@@ -0,0 +1,55 @@
+package upload
+
+import (
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/participation"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type Step string
+
+const (
+	PathStep    Step = "path"
+	WaitingStep Step = "waiting"
+)
+
+var (
+	focusedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	cursorStyle  = focusedStyle
+)
+
+// ViewModel asks for the path of a participation key file and imports it into the node.
+type ViewModel struct {
+	Width  int
+	Height int
+
+	PathInput      textinput.Model
+	PathInputError string
+
+	Step  Step
+	State *algod.StateModel
+}
+
+// Reset clears the input and returns to the path step.
+func (m *ViewModel) Reset() {
+	m.Step = PathStep
+	m.PathInput.SetValue("")
+	m.PathInputError = ""
+	m.PathInput.Focus()
+}
+
+// New creates the import modal.
+func New(state *algod.StateModel) ViewModel {
+	m := ViewModel{
+		State:     state,
+		PathInput: textinput.New(),
+		Step:      PathStep,
+	}
+	m.PathInput.Cursor.Style = cursorStyle
+	m.PathInput.Placeholder = "/path/to/key" + participation.FileExtension
+	m.PathInput.Focus()
+	m.PathInput.PromptStyle = focusedStyle
+	m.PathInput.TextStyle = focusedStyle
+	return m
+}
@@ -0,0 +1,74 @@
+package upload
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/algorandfoundation/nodekit/internal/test"
+	"github.com/algorandfoundation/nodekit/ui/app"
+	uitest "github.com/algorandfoundation/nodekit/ui/internal/test"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+)
+
+func Test_New(t *testing.T) {
+	m := New(uitest.GetState(test.GetClient(false)))
+	if m.Step != PathStep {
+		t.Error("Did not start on the path step")
+	}
+
+	m.PathInput.SetValue("/does/not/exist.partkey")
+	m, cmd := m.HandleMessage(tea.KeyMsg{
+		Type:  tea.KeyRunes,
+		Runes: []rune("enter"),
+	})
+	if cmd != nil || m.PathInputError == "" {
+		t.Error("Did not reject a missing file")
+	}
+
+	path := filepath.Join(t.TempDir(), "key.partkey")
+	if err := os.WriteFile(path, []byte("partkey"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	m.PathInput.SetValue(path)
+	m, cmd = m.HandleMessage(tea.KeyMsg{
+		Type:  tea.KeyRunes,
+		Runes: []rune("enter"),
+	})
+	if cmd == nil || m.Step != WaitingStep {
+		t.Error("Did not start the import")
+	}
+	if _, ok := cmd().(app.KeySelectedEvent); !ok {
+		t.Error("Did not select the imported key")
+	}
+
+	m, _ = m.HandleMessage(errors.New("failed"))
+	if m.Step != PathStep {
+		t.Error("Did not return to the path step on error")
+	}
+
+	_, cmd = m.HandleMessage(tea.KeyMsg{
+		Type:  tea.KeyRunes,
+		Runes: []rune("esc"),
+	})
+	if cmd == nil {
+		t.Error("Did not close the overlay")
+	}
+}
+
+func Test_Snapshot(t *testing.T) {
+	t.Run("Visible", func(t *testing.T) {
+		model := New(uitest.GetState(nil))
+		got := ansi.Strip(model.View())
+		golden.RequireEqual(t, []byte(got))
+	})
+	t.Run("Waiting", func(t *testing.T) {
+		model := New(uitest.GetState(nil))
+		model.Step = WaitingStep
+		got := ansi.Strip(model.View())
+		golden.RequireEqual(t, []byte(got))
+	})
+}
@@ -0,0 +1,83 @@
+package upload
+
+import (
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Title returns the title of the modal.
+func (m ViewModel) Title() string {
+	if m.Step == WaitingStep {
+		return "Importing Key"
+	}
+	return "Import Participation Key"
+}
+
+// BorderColor returns the border color based on the current step.
+func (m ViewModel) BorderColor() string {
+	if m.Step == WaitingStep {
+		return "9"
+	}
+	return "2"
+}
+
+// Controls returns a string representation of the available control options for the ViewModel.
+func (m ViewModel) Controls() string {
+	return ""
+}
+
+// Navigation returns the control instructions for the current step.
+func (m ViewModel) Navigation() string {
+	if m.Step == PathStep {
+		return style.Bold("( esc to cancel )")
+	}
+	return ""
+}
+
+// Body returns the path input or the waiting message.
+func (m ViewModel) Body() string {
+	render := ""
+	switch m.Step {
+	case PathStep:
+		render = lipgloss.JoinVertical(lipgloss.Left,
+			"",
+			"Install a participation key file generated on another machine.",
+			"",
+			"Key file path:",
+			m.PathInput.View(),
+			"",
+		)
+		if m.PathInputError != "" {
+			render = lipgloss.JoinVertical(lipgloss.Left,
+				render,
+				style.Red.Render(m.PathInputError),
+			)
+		}
+	case WaitingStep:
+		render = lipgloss.JoinVertical(lipgloss.Left,
+			"",
+			"Importing the participation key...",
+			"",
+		)
+	}
+
+	return lipgloss.NewStyle().Width(70).Render(render)
+}
+
+// View renders the ViewModel as a styled string, incorporating title, controls, and body content with dynamic borders.
+func (m ViewModel) View() string {
+	body := m.Body()
+	width := lipgloss.Width(body)
+	height := lipgloss.Height(body)
+	return style.WithControls(m.Controls(), style.WithNavigation(
+		m.Navigation(),
+		style.WithTitle(
+			m.Title(),
+			// Apply the Borders with the Padding
+			style.ApplyBorder(width+2, height-4, m.BorderColor()).
+				PaddingRight(1).
+				PaddingLeft(1).
+				Render(body),
+		),
+	))
+}
@@ -1,6 +1,11 @@
 package overlay
 
 import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
 	"github.com/algorandfoundation/nodekit/internal/algod"
 	"github.com/algorandfoundation/nodekit/internal/algod/participation"
 	"github.com/algorandfoundation/nodekit/ui/app"
@@ -17,7 +22,9 @@ func (m ViewModel) Init() tea.Cmd {
 		m.catchupModal.Init(),
 		m.laggingModal.Init(),
 		m.generateModal.Init(),
+		m.importModal.Init(),
 		m.hybridModal.Init(),
+		m.accountModal.Init(),
 	)
 }
 
@@ -54,6 +61,7 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (ViewModel, tea.Cmd) {
 		if ok {
 			m.SetSuspended(acct.Participation != nil && acct.Status == "Offline")
 		}
+		cmds = append(cmds, m.warnAbsenteeism(msg))
 
 		// We found the account, and we are on one of the modals
 		// TODO: move to logic to Transaction and Info Modal based on events
@@ -136,6 +144,7 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (ViewModel, tea.Cmd) {
 			m.Open = false
 			m.SetType(app.InfoModal)
 			m.generateModal.Reset("")
+			m.importModal.Reset()
 		// Handle back navigation
 		case app.OverlayEventCancel:
 			switch m.Type {
@@ -153,7 +162,7 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (ViewModel, tea.Cmd) {
 
 	// Only trigger KeyMsgs when the modal is active
 	case tea.KeyMsg:
-		if msg.String() == "q" && m.Type != app.GenerateModal && m.Open {
+		if msg.String() == "q" && m.Type != app.GenerateModal && m.Type != app.ImportModal && !(m.Type == app.TransactionModal && m.transactionModal.IsTyping()) && m.Open {
 			return m, tea.Quit
 		}
 		// Only trigger modal commands when they are active
@@ -172,8 +181,12 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (ViewModel, tea.Cmd) {
 			m.laggingModal, cmd = m.laggingModal.HandleMessage(msg)
 		case app.GenerateModal:
 			m.generateModal, cmd = m.generateModal.HandleMessage(msg)
+		case app.ImportModal:
+			m.importModal, cmd = m.importModal.HandleMessage(msg)
 		case app.HybridModal:
 			m.hybridModal, cmd = m.hybridModal.HandleMessage(msg)
+		case app.AccountModal:
+			m.accountModal, cmd = m.accountModal.HandleMessage(msg)
 		}
 		// Exit early and don't apply twice
 		cmds = append(cmds, cmd)
@@ -193,14 +206,55 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (ViewModel, tea.Cmd) {
 	cmds = append(cmds, cmd)
 	m.generateModal, cmd = m.generateModal.HandleMessage(msg)
 	cmds = append(cmds, cmd)
+	m.importModal, cmd = m.importModal.HandleMessage(msg)
+	cmds = append(cmds, cmd)
 	m.exceptionModal, cmd = m.exceptionModal.HandleMessage(msg)
 	cmds = append(cmds, cmd)
 	m.hybridModal, cmd = m.hybridModal.HandleMessage(msg)
 	cmds = append(cmds, cmd)
+	m.accountModal, cmd = m.accountModal.HandleMessage(msg)
+	cmds = append(cmds, cmd)
 
 	return m, tea.Batch(cmds...)
 }
 
+// warnAbsenteeism shows the exception modal once for an account at risk of being suspended for not proposing.
+// Accounts are warned again after they were seen by the network.
+func (m *ViewModel) warnAbsenteeism(state *algod.StateModel) tea.Cmd {
+	if m.absenceWarnings == nil {
+		m.absenceWarnings = make(map[string]bool)
+	}
+	addresses := make([]string, 0, len(state.Accounts))
+	for address := range state.Accounts {
+		addresses = append(addresses, address)
+	}
+	slices.Sort(addresses)
+
+	var cmd tea.Cmd
+	for _, address := range addresses {
+		absenteeism, ok := state.Absenteeism(address)
+		if !ok || !absenteeism.AtRisk() {
+			delete(m.absenceWarnings, address)
+			continue
+		}
+		// Do not interrupt another modal, the warning is shown once it is closed
+		if m.absenceWarnings[address] || m.Open || cmd != nil {
+			continue
+		}
+		m.absenceWarnings[address] = true
+		warning := fmt.Sprintf("%s has not proposed a block or sent a heartbeat for %d rounds.\n", address, absenteeism.Rounds)
+		if absenteeism.Absent() {
+			warning += "It is absent and can be suspended at any round"
+		} else {
+			remaining := time.Duration(absenteeism.Remaining()) * state.RoundTime().RoundTime
+			warning += fmt.Sprintf("It will be suspended in about %d rounds (%s)", absenteeism.Remaining(), remaining.Round(time.Second))
+		}
+		warning += ", check that the node is healthy and the participation key is registered."
+		m.exceptionModal, cmd = m.exceptionModal.HandleMessage(errors.New(warning))
+	}
+	return cmd
+}
+
 // Update processes the given message, updates the ViewModel state, and returns the updated model and accompanying commands.
 func (m ViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m.HandleMessage(msg)
@@ -88,3 +88,44 @@ func Test_Messages(t *testing.T) {
 
 	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
 }
+
+func Test_AbsenteeismWarning(t *testing.T) {
+	state := test.GetState(nil)
+	state.Status.LastRound = 1090
+	state.Performance.OnlineMoney = 10000000000000
+	// A tenth of the online stake is absent after 100 rounds
+	acct := state.Accounts[mock.Keys[0].Address]
+	acct.Status = "Online"
+	acct.IncentiveEligible = true
+	acct.Balance = 1000000
+	acct.LastProposed = 1000
+	state.Accounts[mock.Keys[0].Address] = acct
+
+	model := New("", false, state)
+	model, cmd := model.HandleMessage(state)
+	if cmd == nil {
+		t.Fatal("Expected a command to show the warning")
+	}
+	if !bytes.Contains([]byte(model.exceptionModal.Message), []byte("suspended in about 10 rounds")) {
+		t.Errorf("Unexpected warning: %s", model.exceptionModal.Message)
+	}
+
+	// The account is only warned once
+	model.exceptionModal.Message = ""
+	model, _ = model.HandleMessage(state)
+	if model.exceptionModal.Message != "" {
+		t.Error("Expected the account to be warned once")
+	}
+
+	// The account is warned again after it was seen
+	acct.LastProposed = 1080
+	state.Accounts[mock.Keys[0].Address] = acct
+	model, _ = model.HandleMessage(state)
+	acct.LastProposed = 1000
+	state.Status.LastRound = 1200
+	state.Accounts[mock.Keys[0].Address] = acct
+	model, _ = model.HandleMessage(state)
+	if !bytes.Contains([]byte(model.exceptionModal.Message), []byte("can be suspended at any round")) {
+		t.Errorf("Unexpected warning: %s", model.exceptionModal.Message)
+	}
+}
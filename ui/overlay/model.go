@@ -5,6 +5,7 @@ import (
 	"github.com/algorandfoundation/nodekit/internal/algod"
 	"github.com/algorandfoundation/nodekit/internal/algod/participation"
 	"github.com/algorandfoundation/nodekit/ui/app"
+	"github.com/algorandfoundation/nodekit/ui/modals/account"
 	"github.com/algorandfoundation/nodekit/ui/modals/catchup"
 	"github.com/algorandfoundation/nodekit/ui/modals/catchup/lagging"
 	"github.com/algorandfoundation/nodekit/ui/modals/exception"
@@ -13,6 +14,7 @@ import (
 	"github.com/algorandfoundation/nodekit/ui/modals/partkey/generate"
 	"github.com/algorandfoundation/nodekit/ui/modals/partkey/info"
 	"github.com/algorandfoundation/nodekit/ui/modals/partkey/transaction"
+	"github.com/algorandfoundation/nodekit/ui/modals/partkey/upload"
 )
 
 type ViewModel struct {
@@ -33,6 +35,9 @@ type ViewModel struct {
 	// HasPrefix indicates whether a prefix is used or active.
 	HasPrefix bool
 
+	// absenceWarnings are the addresses already warned about being at risk of suspension
+	absenceWarnings map[string]bool
+
 	// Link represents a reference to a ShortLinkResponse,
 	// typically used for processing or displaying shortened link data.
 	Link *participation.ShortLinkResponse
@@ -44,8 +49,10 @@ type ViewModel struct {
 	laggingModal     lagging.ViewModel
 	confirmModal     delete.ViewModel
 	generateModal    generate.ViewModel
+	importModal      upload.ViewModel
 	exceptionModal   exception.ViewModel
 	hybridModal      hybrid.ViewModel
+	accountModal     account.ViewModel
 
 	// Current Component Data
 	title       string
@@ -97,8 +104,12 @@ func New(parent string, open bool, state *algod.StateModel) ViewModel {
 		laggingModal:     lagging.New(state),
 		confirmModal:     delete.New(state, nil),
 		generateModal:    generate.New("", state),
+		importModal:      upload.New(state),
 		exceptionModal:   exception.New(""),
 		hybridModal:      hybrid.New(state),
+		accountModal:     account.New(state),
+
+		absenceWarnings: make(map[string]bool),
 
 		Type:        app.InfoModal,
 		controls:    "",
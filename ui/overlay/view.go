@@ -24,10 +24,14 @@ func (m ViewModel) View() string {
 		render = m.confirmModal.View()
 	case app.GenerateModal:
 		render = m.generateModal.View()
+	case app.ImportModal:
+		render = m.importModal.View()
 	case app.ExceptionModal:
 		render = m.exceptionModal.View()
 	case app.HybridModal:
 		render = m.hybridModal.View()
+	case app.AccountModal:
+		render = m.accountModal.View()
 	}
 
 	return style.WithOverlay(render, m.Parent)
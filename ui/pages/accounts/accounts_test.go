@@ -42,6 +42,31 @@ func Test_New(t *testing.T) {
 		t.Errorf("expected true, got false")
 	}
 
+	// Show the details of the selected account
+	_, cmd = m.HandleMessage(tea.KeyMsg{
+		Type:  tea.KeyRunes,
+		Runes: []rune("d"),
+	})
+	if cmd == nil {
+		t.Errorf("Expected a command to show the account details")
+	}
+
+	// Warn about accounts at risk of suspension
+	acct := m.Data.Accounts[acc.Address]
+	acct.Status = "Online"
+	acct.Balance = 1000000
+	acct.LastProposed = 1000
+	m.Data.Accounts[acc.Address] = acct
+	m.Data.Performance.OnlineMoney = 10000000000000
+	m.Data.Status.LastRound = 1060
+	if row := (*m.makeRows())[0]; row[1] != "⚠ AT RISK" {
+		t.Errorf("Expected the account to be at risk, got %s", row[1])
+	}
+	m.Data.Status.LastRound = 1200
+	if row := (*m.makeRows())[0]; row[1] != "⚠ ABSENT" {
+		t.Errorf("Expected the account to be absent, got %s", row[1])
+	}
+
 	// Update syncing state
 	m.Data.Status.State = algod.SyncingState
 	m.makeRows()
@@ -58,6 +83,16 @@ func Test_Snapshot(t *testing.T) {
 		got := ansi.Strip(model.View())
 		golden.RequireEqual(t, []byte(got))
 	})
+	// The controls fit next to the navigation at 80 columns
+	t.Run("Controls", func(t *testing.T) {
+		state := test.GetState(nil)
+		state.Status.LastRound = 1000
+		model := New(state)
+
+		model, _ = model.HandleMessage(tea.WindowSizeMsg{Width: 80, Height: 20})
+		got := ansi.Strip(model.View())
+		golden.RequireEqual(t, []byte(got))
+	})
 }
 
 func Test_Messages(t *testing.T) {
@@ -32,6 +32,16 @@ func (m ViewModel) HandleMessage(msg tea.Msg) (ViewModel, tea.Cmd) {
 				)
 			}
 			return m, nil
+		case "d":
+			// Show the proposal performance of the account
+			selAcc := m.SelectedAccount()
+			if selAcc != nil {
+				return m, tea.Sequence(
+					app.EmitAccountSelected(selAcc),
+					app.EmitShowModal(app.AccountModal),
+				)
+			}
+			return m, nil
 		}
 	case tea.WindowSizeMsg:
 		borderRender := style.Border.Render("")
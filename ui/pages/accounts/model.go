@@ -17,9 +17,11 @@ var maxEligibleBalance = 70_000_000
 type ViewModel struct {
 	Data *algod.StateModel
 
-	Title       string
-	Navigation  string
-	Controls    string
+	Title      string
+	Navigation string
+	Controls   string
+	// Help lists the secondary controls on the top border, the bottom border is shared with the navigation
+	Help        string
 	BorderColor string
 	Width       int
 	Height      int
@@ -34,7 +36,8 @@ func New(state *algod.StateModel) ViewModel {
 		Height:      0,
 		BorderColor: "6",
 		Data:        state,
-		Controls:    "( (g)enerate | (enter) to select )",
+		Controls:    "( (g)en | (i)mport | (enter) to select )",
+		Help:        "( (m)etrics | (d)etails )",
 		Navigation:  "| -> | " + style.Green.Render("accounts") + " | keys |",
 	}
 
@@ -93,8 +96,12 @@ func (m ViewModel) makeRows() *[]table.Row {
 				expires = m.Data.Accounts[addr].Expires.Format(time.RFC822)
 			}
 
-			// Expires within the week
-			if m.Data.Accounts[addr].Expires.Before(time.Now().Add(time.Hour * 24 * 7)) {
+			// Expires within the week, warn as soon as the earliest estimate is close
+			earliest := m.Data.Accounts[addr].Expires
+			if m.Data.Accounts[addr].ExpiresEarliest != nil {
+				earliest = m.Data.Accounts[addr].ExpiresEarliest
+			}
+			if earliest.Before(time.Now().Add(time.Hour * 24 * 7)) {
 				expires = "⚠ " + expires
 			}
 		}
@@ -110,23 +117,35 @@ func (m ViewModel) makeRows() *[]table.Row {
 			}
 		}
 
+		// The keys of watched accounts live on another node
+		if m.Data.Accounts[addr].WatchOnly {
+			expires = "REMOTE"
+		}
+
 		status := m.Data.Accounts[addr].Status
-		if status == "Online" && !expired {
+		participating := status == "Online" && !expired
+		if participating {
 			status = "PARTICIPATING"
+			// Warn before the protocol suspends the account for not proposing
+			if absenteeism, ok := m.Data.Absenteeism(addr); ok && absenteeism.Absent() {
+				status = "⚠ ABSENT"
+			} else if ok && absenteeism.AtRisk() {
+				status = "⚠ AT RISK"
+			}
 		} else {
 			status = "IDLE"
 		}
 
 		incentiveLevel := ""
 		balance := m.Data.Accounts[addr].Balance
-		if m.Data.Accounts[addr].IncentiveEligible && status == "PARTICIPATING" {
+		if m.Data.Accounts[addr].IncentiveEligible && participating {
 			if balance >= minEligibleBalance && balance <= maxEligibleBalance {
 				incentiveLevel = "ELIGIBLE"
 			} else {
 				incentiveLevel = "PAUSED"
 			}
 		} else {
-			if status == "PARTICIPATING" {
+			if participating {
 				incentiveLevel = "INELIGIBLE"
 			} else {
 				incentiveLevel = ""
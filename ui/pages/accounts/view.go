@@ -16,7 +16,7 @@ func (m ViewModel) View() string {
 			ctls,
 			style.WithTitle(
 				m.Title,
-				table,
+				style.WithHelp(m.Help, table),
 			),
 		),
 	)
@@ -0,0 +1,52 @@
+package fleet
+
+import (
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/ui/app"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func (m ViewModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m ViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return m.HandleMessage(msg)
+}
+
+func (m ViewModel) HandleMessage(msg tea.Msg) (ViewModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	// Any node changed, the states are shared so only the rows need a refresh
+	case *algod.StateModel:
+		m.table.SetRows(*m.makeRows())
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "enter", "right":
+			node := m.SelectedNode()
+			// A node that could not be watched has no accounts or keys to show
+			if node != nil && node.Err == nil {
+				return m, app.EmitNodeSelected(app.NodeSelected{Name: node.Name, State: node.State})
+			}
+			return m, nil
+		}
+	case tea.WindowSizeMsg:
+		borderRender := style.Border.Render("")
+		borderWidth := lipgloss.Width(borderRender)
+		borderHeight := lipgloss.Height(borderRender)
+
+		m.Width = max(0, msg.Width-borderWidth)
+		m.Height = max(0, msg.Height-borderHeight)
+
+		m.table.SetWidth(m.Width)
+		// The errors of the nodes that could not be watched are listed under the table
+		m.table.SetHeight(max(0, m.Height-len(m.errors())))
+		m.table.SetColumns(m.makeColumns(m.Width))
+	}
+
+	// Handle Table Update
+	m.table, _ = m.table.Update(msg)
+
+	return m, nil
+}
@@ -0,0 +1,95 @@
+package fleet
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/test/mock"
+	"github.com/algorandfoundation/nodekit/ui/app"
+	"github.com/algorandfoundation/nodekit/ui/internal/test"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+)
+
+func getNodes() []Node {
+	testnet := test.GetState(nil)
+	testnet.Status.Network = "testnet-v1.0"
+	testnet.Status.State = algod.SyncingState
+	testnet.Status.NeedsUpdate = true
+	relay := test.GetState(nil)
+	relay.Admin = true
+	return []Node{
+		{Name: "mainnet", State: test.GetState(nil)},
+		{Name: "testnet", State: testnet},
+		{Name: "relay", State: relay},
+		{
+			Name:  "backup",
+			State: &algod.StateModel{Status: algod.Status{State: algod.DownState}},
+			Err:   errors.New("invalid data directory"),
+		},
+	}
+}
+
+func Test_New(t *testing.T) {
+	m := New(nil)
+	if m.SelectedNode() != nil {
+		t.Error("Expected no node to be selected")
+	}
+	_, cmd := m.HandleMessage(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd != nil {
+		t.Error("Expected no command without nodes")
+	}
+
+	nodes := getNodes()
+	m = New(nodes)
+	m, _ = m.HandleMessage(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m, _ = m.HandleMessage(tea.KeyMsg{Type: tea.KeyDown})
+	if m.SelectedNode().Name != "testnet" {
+		t.Errorf("Expected testnet to be selected, got %s", m.SelectedNode().Name)
+	}
+	_, cmd = m.HandleMessage(tea.KeyMsg{Type: tea.KeyEnter})
+	msg, ok := cmd().(app.NodeSelected)
+	if !ok || msg.State != nodes[1].State {
+		t.Error("Expected the testnet node to be selected")
+	}
+
+	// A node that could not be watched can not be selected
+	m, _ = m.HandleMessage(tea.KeyMsg{Type: tea.KeyDown})
+	m, _ = m.HandleMessage(tea.KeyMsg{Type: tea.KeyDown})
+	if m.SelectedNode().Name != "backup" {
+		t.Errorf("Expected backup to be selected, got %s", m.SelectedNode().Name)
+	}
+	_, cmd = m.HandleMessage(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd != nil {
+		t.Error("Expected no command for a node that could not be watched")
+	}
+
+	// The registered key of ABC is installed on mainnet and relay
+	nodes[0].State.Admin = true
+	for _, i := range []int{0, 2} {
+		acct := nodes[i].State.Accounts["ABC"]
+		acct.Participation = &mock.Keys[0].Key
+		nodes[i].State.Accounts["ABC"] = acct
+	}
+	rows := *New(nodes).makeRows()
+	if rows[0][7] != "⚠ DUPLICATE 3" || rows[1][7] != "N/A" {
+		t.Errorf("Expected mainnet to have duplicate keys, got %s and %s", rows[0][7], rows[1][7])
+	}
+	if rows[3][3] != "⚠ DOWN" {
+		t.Errorf("Expected the backup node to be down, got %s", rows[3][3])
+	}
+	if errors := New(nodes).errors(); len(errors) != 1 || errors[0] != "⚠ backup: invalid data directory" {
+		t.Errorf("Expected the error of the backup node, got %v", errors)
+	}
+}
+
+func Test_Snapshot(t *testing.T) {
+	t.Run("Visible", func(t *testing.T) {
+		model := New(getNodes())
+		model, _ = model.HandleMessage(tea.WindowSizeMsg{Width: 120, Height: 20})
+		got := ansi.Strip(model.View())
+		golden.RequireEqual(t, []byte(got))
+	})
+}
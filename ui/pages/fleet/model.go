@@ -0,0 +1,150 @@
+package fleet
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Node is a named node of the fleet with its watched state.
+type Node struct {
+	Name  string
+	State *algod.StateModel
+	// Err is why the node could not be watched, its State is only a DOWN placeholder
+	Err error
+}
+
+// ViewModel represents the fleet overview page listing every node of the session.
+type ViewModel struct {
+	// Nodes of the fleet in display order
+	Nodes []Node
+
+	// Title represents the title displayed at the top of the ViewModel's UI.
+	Title string
+	// Controls describe the set of actions or commands available for the user to interact with the ViewModel.
+	Controls string
+	// Navigation represents the navigation bar or breadcrumbs in the ViewModel's UI, indicating the current page or section.
+	Navigation string
+	// BorderColor represents the color of the border in the ViewModel's UI.
+	BorderColor string
+	// Width represents the width of the ViewModel's UI in terms of display units.
+	Width int
+	// Height represents the height of the ViewModel's UI in terms of display units.
+	Height int
+
+	// table manages the tabular representation of the nodes in the ViewModel.
+	table table.Model
+}
+
+// New initializes and returns a new ViewModel for the nodes.
+func New(nodes []Node) ViewModel {
+	m := ViewModel{
+		Nodes:       nodes,
+		Title:       "Fleet",
+		Controls:    "( (enter) to select )",
+		Navigation:  "| -> | " + style.Green.Render("fleet") + " | accounts | keys |",
+		BorderColor: "5",
+	}
+
+	m.table = table.New(
+		table.WithColumns(m.makeColumns(0)),
+		table.WithRows(*m.makeRows()),
+		table.WithFocused(true),
+	)
+	s := table.DefaultStyles()
+	s.Header = s.Header.
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("240")).
+		BorderBottom(true).
+		Bold(false)
+	s.Selected = s.Selected.
+		Foreground(lipgloss.Color("229")).
+		Background(lipgloss.Color(m.BorderColor)).
+		Bold(false)
+	m.table.SetStyles(s)
+	return m
+}
+
+// errors lists why the nodes that could not be watched are down, one line per node.
+func (m ViewModel) errors() []string {
+	var lines []string
+	for _, node := range m.Nodes {
+		if node.Err != nil {
+			lines = append(lines, fmt.Sprintf("⚠ %s: %s", node.Name, node.Err))
+		}
+	}
+	return lines
+}
+
+// SelectedNode returns the highlighted node, or nil when the fleet is empty.
+func (m ViewModel) SelectedNode() *Node {
+	cursor := m.table.Cursor()
+	if cursor < 0 || cursor >= len(m.Nodes) {
+		return nil
+	}
+	return &m.Nodes[cursor]
+}
+
+func (m ViewModel) makeColumns(width int) []table.Column {
+	avgWidth := (width - lipgloss.Width(style.Border.Render("")) - 16) / 8
+	return []table.Column{
+		{Title: "Node", Width: avgWidth},
+		{Title: "Network", Width: avgWidth},
+		{Title: "Round", Width: avgWidth},
+		{Title: "State", Width: avgWidth},
+		{Title: "Version", Width: avgWidth},
+		{Title: "Update", Width: avgWidth},
+		{Title: "Accounts", Width: avgWidth},
+		{Title: "Keys", Width: avgWidth},
+	}
+}
+
+func (m ViewModel) makeRows() *[]table.Row {
+	states := make([]*algod.StateModel, 0, len(m.Nodes))
+	for _, node := range m.Nodes {
+		states = append(states, node.State)
+	}
+	// Nodes holding the same registered participation key
+	duplicates := make(map[int]bool)
+	for _, indexes := range algod.DuplicateKeys(states) {
+		for _, i := range indexes {
+			duplicates[i] = true
+		}
+	}
+
+	rows := make([]table.Row, 0, len(m.Nodes))
+	for i, node := range m.Nodes {
+		state := node.State
+		update := ""
+		if state.Status.NeedsUpdate {
+			update = "AVAILABLE"
+		}
+		accounts, keys := "N/A", "N/A"
+		if state.Admin {
+			accounts = strconv.Itoa(len(state.Accounts))
+			keys = strconv.Itoa(len(state.ParticipationKeys))
+		}
+		if duplicates[i] {
+			keys = "⚠ DUPLICATE " + keys
+		}
+		status := string(state.Status.State)
+		if node.Err != nil {
+			status = "⚠ " + status
+		}
+		rows = append(rows, table.Row{
+			node.Name,
+			state.Status.Network,
+			strconv.FormatUint(state.Status.LastRound, 10),
+			status,
+			state.Status.Version,
+			update,
+			accounts,
+			keys,
+		})
+	}
+	return &rows
+}
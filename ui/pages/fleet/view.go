@@ -0,0 +1,26 @@
+package fleet
+
+import (
+	"strings"
+
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func (m ViewModel) View() string {
+	content := m.table.View()
+	if errors := m.errors(); len(errors) > 0 {
+		content = lipgloss.JoinVertical(lipgloss.Left, content, style.Red.Render(strings.Join(errors, "\n")))
+	}
+	table := style.ApplyBorder(m.Width, m.Height, m.BorderColor).Render(content)
+	return style.WithNavigation(
+		m.Navigation,
+		style.WithControls(
+			m.Controls,
+			style.WithTitle(
+				m.Title,
+				table,
+			),
+		),
+	)
+}
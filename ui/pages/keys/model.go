@@ -52,7 +52,7 @@ func New(address string, keys participation.List) ViewModel {
 
 		// Page Wrapper
 		Title:       "Keys",
-		Controls:    "( (g)enerate | (enter) to select | (esc) to go back )",
+		Controls:    "( (g)en | (i)mport | (enter) | (esc) back )",
 		Navigation:  "| <- | accounts | " + style.Green.Render("keys") + " |",
 		BorderColor: "4",
 	}
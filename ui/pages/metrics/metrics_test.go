@@ -0,0 +1,65 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/ui/app"
+	"github.com/algorandfoundation/nodekit/ui/internal/test"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/exp/golden"
+)
+
+func getState() *algod.StateModel {
+	state := test.GetState(nil)
+	state.Metrics.History = algod.NewMetricsHistory(algod.DefaultHistorySize)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 120; i++ {
+		state.Metrics.History.Push(algod.MetricsSample{
+			Round:     uint64(1000 + i*5),
+			Time:      start.Add(time.Duration(i*14) * time.Second),
+			RoundTime: time.Duration(2800+i*5) * time.Millisecond,
+			TPS:       float64(i % 12),
+			RX:        120_000 - i*1000,
+			TX:        60_000 - i*500,
+		})
+	}
+	return state
+}
+
+func Test_New(t *testing.T) {
+	m := New(test.GetState(nil))
+	m, cmd := m.HandleMessage(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd == nil || cmd() != app.AccountsPage {
+		t.Error("Expected to go back to the accounts page")
+	}
+
+	state := getState()
+	m, _ = m.HandleMessage(state)
+	if m.Data != state {
+		t.Error("Expected the state to be updated")
+	}
+}
+
+func Test_Snapshot(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
+		model := New(test.GetState(nil))
+		model, _ = model.HandleMessage(tea.WindowSizeMsg{Width: 120, Height: 20})
+		got := ansi.Strip(model.View())
+		golden.RequireEqual(t, []byte(got))
+	})
+	t.Run("History", func(t *testing.T) {
+		model := New(getState())
+		model, _ = model.HandleMessage(tea.WindowSizeMsg{Width: 120, Height: 24})
+		got := ansi.Strip(model.View())
+		golden.RequireEqual(t, []byte(got))
+	})
+	t.Run("Compact", func(t *testing.T) {
+		model := New(getState())
+		model, _ = model.HandleMessage(tea.WindowSizeMsg{Width: 60, Height: 30})
+		got := ansi.Strip(model.View())
+		golden.RequireEqual(t, []byte(got))
+	})
+}
@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/ui/style"
+)
+
+// ViewModel represents the page charting the metrics history of the node.
+type ViewModel struct {
+	// Data is the state of the node with the metrics history
+	Data *algod.StateModel
+
+	// Title represents the title displayed at the top of the ViewModel's UI.
+	Title string
+	// Controls describe the set of actions or commands available for the user to interact with the ViewModel.
+	Controls string
+	// Navigation represents the navigation bar or breadcrumbs in the ViewModel's UI, indicating the current page or section.
+	Navigation string
+	// BorderColor represents the color of the border in the ViewModel's UI.
+	BorderColor string
+	// Width represents the width of the ViewModel's UI in terms of display units.
+	Width int
+	// Height represents the height of the ViewModel's UI in terms of display units.
+	Height int
+}
+
+// New initializes and returns a new ViewModel for the metrics of the node.
+func New(state *algod.StateModel) ViewModel {
+	return ViewModel{
+		Data:        state,
+		Title:       "Metrics",
+		Controls:    "( (m) or (esc) to go back )",
+		Navigation:  "| <- | accounts | " + style.Green.Render("metrics") + " |",
+		BorderColor: "5",
+	}
+}
@@ -0,0 +1,110 @@
+package metrics
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/algorandfoundation/nodekit/ui/utils"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// chart is a single metric of the history
+type chart struct {
+	title  string
+	values []float64
+	format func(float64) string
+}
+
+func formatSeconds(v float64) string {
+	return fmt.Sprintf("%.2fs", v)
+}
+
+func formatTPS(v float64) string {
+	return fmt.Sprintf("%.2f", v)
+}
+
+func formatBytes(v float64) string {
+	return strings.TrimSpace(utils.BitRate(int(v)))
+}
+
+// render draws the chart with its latest, lowest and highest values into the width and height.
+func (c chart) render(width int, height int) string {
+	if len(c.values) == 0 {
+		return ""
+	}
+	low, high := c.values[0], c.values[0]
+	for _, v := range c.values {
+		low = min(low, v)
+		high = max(high, v)
+	}
+	title := style.Blue.Render(c.title+": ") + c.format(c.values[len(c.values)-1]) +
+		style.Cyan.Render(fmt.Sprintf("  min %s  max %s", c.format(low), c.format(high)))
+	return lipgloss.NewStyle().Width(width).Render(
+		lipgloss.JoinVertical(lipgloss.Left,
+			title,
+			style.Cyan.Render(style.Chart(c.values, width, max(1, height-1))),
+		),
+	)
+}
+
+// charts returns the round time, TPS and traffic history of the node.
+func (m ViewModel) charts() []chart {
+	history := m.Data.Metrics.History
+	return []chart{
+		{title: "Round time", values: history.RoundTimes(), format: formatSeconds},
+		{title: "TPS", values: history.TPS(), format: formatTPS},
+		{title: "RX", values: history.RX(), format: formatBytes},
+		{title: "TX", values: history.TX(), format: formatBytes},
+	}
+}
+
+// span describes the rounds and time covered by the history.
+func (m ViewModel) span() string {
+	samples := m.Data.Metrics.History.Values()
+	first, last := samples[0], samples[len(samples)-1]
+	return fmt.Sprintf(" %d samples, rounds %d-%d over %s",
+		len(samples), first.Round, last.Round, last.Time.Sub(first.Time).Round(time.Second))
+}
+
+func (m ViewModel) View() string {
+	var content string
+	if m.Data == nil || m.Data.Metrics.History.Len() == 0 {
+		content = lipgloss.Place(m.Width, m.Height, lipgloss.Center, lipgloss.Center,
+			"Collecting metrics, a sample is taken every 5 rounds")
+	} else {
+		// Two columns of charts when there is room, otherwise stack them
+		columns := 1
+		if m.Width >= 80 {
+			columns = 2
+		}
+		charts := m.charts()
+		rows := len(charts) / columns
+		width := m.Width/columns - 2
+		height := max(2, (m.Height-2)/rows-1)
+
+		var lines []string
+		for i := 0; i < len(charts); i += columns {
+			var row []string
+			for _, c := range charts[i : i+columns] {
+				row = append(row, lipgloss.NewStyle().Padding(0, 1).Render(c.render(width, height)))
+			}
+			lines = append(lines, lipgloss.JoinHorizontal(lipgloss.Top, row...), "")
+		}
+		lines = append(lines, style.Cyan.Render(m.span()))
+		content = lipgloss.NewStyle().MaxHeight(m.Height).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+	}
+
+	render := style.ApplyBorder(m.Width, m.Height, m.BorderColor).Render(content)
+	return style.WithNavigation(
+		m.Navigation,
+		style.WithControls(
+			m.Controls,
+			style.WithTitle(
+				m.Title,
+				render,
+			),
+		),
+	)
+}
@@ -2,13 +2,15 @@ package ui
 
 import (
 	"fmt"
-	"math"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/algorandfoundation/nodekit/internal/algod"
+	"github.com/algorandfoundation/nodekit/internal/algod/config"
+	"github.com/algorandfoundation/nodekit/internal/system"
 	"github.com/algorandfoundation/nodekit/ui/style"
+	"github.com/algorandfoundation/nodekit/ui/utils"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -19,6 +21,9 @@ type StatusViewModel struct {
 	TerminalWidth  int
 	TerminalHeight int
 	IsVisible      bool
+
+	// Clock is the time of the service uptime, the system clock when nil
+	Clock system.Time
 }
 
 // Init has no I/O right now
@@ -46,20 +51,62 @@ func (m StatusViewModel) HandleMessage(msg tea.Msg) (StatusViewModel, tea.Cmd) {
 	return m, nil
 }
 
-// getBitRate converts a given byte rate to a human-readable string format. The output may vary from B/s to GB/s.
-func getBitRate(bytes int) string {
-	txString := fmt.Sprintf("%d B/s ", bytes)
-	if bytes >= 1024 {
-		txString = fmt.Sprintf("%d KB/s ", bytes/(1<<10))
+// trendWidth is the largest width of the sparklines in the status rows.
+const trendWidth = 20
+
+// getTrends renders the sparklines of the left and right values of a row within the space left by its text.
+// Nothing is rendered until the history has enough samples to show a trend.
+func getTrends(size int, beginning string, end string, left []float64, right []float64) (string, string) {
+	width := min(trendWidth, (size-(lipgloss.Width(beginning)+lipgloss.Width(end)+2))/2-2)
+	if width <= 0 {
+		return "", ""
+	}
+	var leftTrend, rightTrend string
+	if len(left) > 1 {
+		leftTrend = " " + style.Cyan.Render(style.Sparkline(left, width))
+	}
+	if len(right) > 1 {
+		rightTrend = style.Cyan.Render(style.Sparkline(right, width)) + " "
+	}
+	return leftTrend, rightTrend
+}
+
+// serviceView renders the state of the algod service, highlighting a stopped or crash-looping service.
+// It is empty for remote nodes.
+func (m StatusViewModel) serviceView() string {
+	service := m.Data.Service
+	if service == nil {
+		return ""
 	}
-	if bytes >= int(math.Pow(1024, 2)) {
-		txString = fmt.Sprintf("%d MB/s ", bytes/(1<<20))
+	var clock system.Time = system.Clock{}
+	if m.Clock != nil {
+		clock = m.Clock
 	}
-	if bytes >= int(math.Pow(1024, 3)) {
-		txString = fmt.Sprintf("%d GB/s ", bytes/(1<<30))
+	now := clock.Now()
+
+	restarts := ""
+	if service.Restarts > 0 {
+		restarts = fmt.Sprintf(", %d %s", service.Restarts, utils.Plural("restart", int(service.Restarts)))
+	}
+	switch {
+	case service.IsCrashLooping(now):
+		state := service.SubState
+		if service.Running {
+			state = "running " + utils.Uptime(service.Uptime(now))
+		}
+		return style.Yellow.Render("CRASH-LOOP") + " " + state + restarts
+	case service.Running:
+		return style.Green.Render("running") + " " + utils.Uptime(service.Uptime(now)) + restarts
 	}
 
-	return txString
+	state := "stopped"
+	if service.ActiveState != "" {
+		state = service.ActiveState
+	}
+	if service.Result != "" && service.Result != "success" {
+		state += fmt.Sprintf(" (%s %d)", service.Result, service.ExitStatus)
+	}
+	return style.Red.Render(state) + restarts
 }
 
 // View handles the render cycle
@@ -94,12 +141,20 @@ func (m StatusViewModel) View() string {
 	// Last Round
 	row1 := lipgloss.JoinHorizontal(lipgloss.Left, beginning, middle, end)
 
-	if m.Data.Config.EnableP2PHybridMode != nil && *m.Data.Config.EnableP2PHybridMode {
+	if m.Data.Config != nil && m.Data.Config.EnableP2PHybridMode != nil && *m.Data.Config.EnableP2PHybridMode {
 		end = "P2P: " + style.Green.Render("YES") + " "
 	} else {
 		end = "P2P: " + style.Red.Render("NO") + " "
 	}
 	beginning = ""
+	if m.Data.Config != nil {
+		if profile := config.DescribeProfile(m.Data.Profile, *m.Data.Config); profile != "" {
+			beginning = style.Blue.Render(" Profile: ") + profile
+		}
+	}
+	if service := m.serviceView(); service != "" {
+		beginning += style.Blue.Render(" Service: ") + service
+	}
 	middle = strings.Repeat(" ", max(0, size-(lipgloss.Width(beginning)+lipgloss.Width(end)+2)))
 	row2 := lipgloss.JoinHorizontal(lipgloss.Left, beginning, middle, end)
 
@@ -107,8 +162,19 @@ func (m StatusViewModel) View() string {
 	if m.Data.Status.State != algod.StableState {
 		roundTime = "--"
 	}
+	// Round times and TPS of a syncing node are not meaningful
+	history := m.Data.Metrics.History
+	var roundTimes, tpsHistory []float64
+	if m.Data.Status.State == algod.StableState {
+		roundTimes = history.RoundTimes()
+		tpsHistory = history.TPS()
+	}
+
 	beginning = style.Blue.Render(" Round time: ") + roundTime
-	end = getBitRate(m.Data.Metrics.TX) + style.Green.Render("TX ")
+	end = utils.BitRate(m.Data.Metrics.TX) + style.Green.Render("TX ")
+	leftTrend, rightTrend := getTrends(size, beginning, end, roundTimes, history.TX())
+	beginning += leftTrend
+	end = rightTrend + end
 	middle = strings.Repeat(" ", max(0, size-(lipgloss.Width(beginning)+lipgloss.Width(end)+2)))
 
 	row3 := lipgloss.JoinHorizontal(lipgloss.Left, beginning, middle, end)
@@ -118,7 +184,10 @@ func (m StatusViewModel) View() string {
 		tps = "--"
 	}
 	beginning = style.Blue.Render(" TPS: ") + tps
-	end = getBitRate(m.Data.Metrics.RX) + style.Green.Render("RX ")
+	end = utils.BitRate(m.Data.Metrics.RX) + style.Green.Render("RX ")
+	leftTrend, rightTrend = getTrends(size, beginning, end, tpsHistory, history.RX())
+	beginning += leftTrend
+	end = rightTrend + end
 	middle = strings.Repeat(" ", max(0, size-(lipgloss.Width(beginning)+lipgloss.Width(end)+2)))
 
 	row4 := lipgloss.JoinHorizontal(lipgloss.Left, beginning, middle, end)
@@ -7,6 +7,7 @@ import (
 
 	"github.com/algorandfoundation/nodekit/internal/algod"
 	"github.com/algorandfoundation/nodekit/internal/algod/config"
+	"github.com/algorandfoundation/nodekit/internal/test/mock"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/x/ansi"
@@ -19,7 +20,48 @@ func Bool(b bool) *bool {
 	return &b
 }
 
+// getHistory returns a history with rising round times and falling traffic
+func getHistory() algod.MetricsHistory {
+	history := algod.NewMetricsHistory(algod.DefaultHistorySize)
+	for i := 0; i < 40; i++ {
+		history.Push(algod.MetricsSample{
+			Round:     uint64(i * 5),
+			RoundTime: time.Duration(2800+i*10) * time.Millisecond,
+			TPS:       float64(i % 8),
+			RX:        4000 - i*100,
+			TX:        2000 - i*50,
+		})
+	}
+	return history
+}
+
+// serviceStartedAt is three hours before the time of the mock clock
+var serviceStartedAt = time.Time{}.Add(-3 * time.Hour)
+
 var statusViewSnapshots = map[string]StatusViewModel{
+	"Trends": {
+		Data: &algod.StateModel{
+			Version: "v0.0.0-test",
+			Status: algod.Status{
+				LastRound: 1337,
+				State:     algod.StableState,
+			},
+			Metrics: algod.Metrics{
+				Window:    100,
+				RoundTime: 3190 * time.Millisecond,
+				TPS:       7,
+				RX:        100,
+				TX:        50,
+				History:   getHistory(),
+			},
+			Config: &config.Config{
+				EnableP2PHybridMode: Bool(true),
+			},
+		},
+		TerminalWidth:  180,
+		TerminalHeight: 80,
+		IsVisible:      true,
+	},
 	"Syncing": {
 		Data: &algod.StateModel{
 			Version: "v0.0.0-test",
@@ -40,6 +82,76 @@ var statusViewSnapshots = map[string]StatusViewModel{
 		TerminalHeight: 80,
 		IsVisible:      true,
 	},
+	"Profile": {
+		Data: &algod.StateModel{
+			Version: "v0.0.0-test",
+			Status: algod.Status{
+				LastRound: 1337,
+				State:     algod.StableState,
+			},
+			Metrics: algod.Metrics{
+				Window:    100,
+				RoundTime: 2800 * time.Millisecond,
+			},
+			Config:  &config.Profiles[0].Config,
+			Profile: &config.AppliedProfile{Name: config.Profiles[0].Name},
+		},
+		TerminalWidth:  180,
+		TerminalHeight: 80,
+		IsVisible:      true,
+	},
+	"Service": {
+		Data: &algod.StateModel{
+			Version: "v0.0.0-test",
+			Status: algod.Status{
+				LastRound: 1337,
+				State:     algod.StableState,
+			},
+			Metrics: algod.Metrics{
+				Window:    100,
+				RoundTime: 2800 * time.Millisecond,
+			},
+			Config: &config.Config{
+				EnableP2PHybridMode: Bool(true),
+			},
+			Service: &algod.ServiceStatus{
+				Manager:     algod.SystemdManager,
+				ActiveState: "active",
+				SubState:    "running",
+				Running:     true,
+				StartedAt:   &serviceStartedAt,
+				Restarts:    1,
+			},
+		},
+		TerminalWidth:  180,
+		TerminalHeight: 80,
+		IsVisible:      true,
+		Clock:          new(mock.Clock),
+	},
+	"CrashLoop": {
+		Data: &algod.StateModel{
+			Version: "v0.0.0-test",
+			Status: algod.Status{
+				LastRound: 1337,
+				State:     algod.DownState,
+			},
+			Config: &config.Config{
+				EnableP2PHybridMode: Bool(true),
+			},
+			Service: &algod.ServiceStatus{
+				Manager:     algod.SystemdManager,
+				ActiveState: "activating",
+				SubState:    "auto-restart",
+				Restarts:    7,
+				Result:      "exit-code",
+				ExitStatus:  1,
+			},
+		},
+		TerminalWidth:  180,
+		TerminalHeight: 80,
+		IsVisible:      true,
+		Clock:          new(mock.Clock),
+	},
 	"Hidden": {
 		Data: &algod.StateModel{
 			Version: "v0.0.0-test",
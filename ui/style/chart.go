@@ -0,0 +1,92 @@
+package style
+
+import (
+	"math"
+	"strings"
+)
+
+// blocks are the eighths of a character cell, from empty to full
+var blocks = []rune{' ', '▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
+// Resample fits the values into width points by averaging consecutive values.
+// Values that already fit are returned as is.
+func Resample(values []float64, width int) []float64 {
+	if width <= 0 {
+		return nil
+	}
+	if len(values) <= width {
+		return values
+	}
+	points := make([]float64, width)
+	for i := range points {
+		start := i * len(values) / width
+		end := (i + 1) * len(values) / width
+		var sum float64
+		for _, v := range values[start:end] {
+			sum += v
+		}
+		points[i] = sum / float64(end-start)
+	}
+	return points
+}
+
+// bounds returns the smallest and largest values, ignoring NaN.
+func bounds(values []float64) (float64, float64) {
+	low, high := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		if math.IsNaN(v) {
+			continue
+		}
+		low = math.Min(low, v)
+		high = math.Max(high, v)
+	}
+	return low, high
+}
+
+// Sparkline renders the values as a single line of at most width characters.
+// Each character is scaled between the smallest and largest value, so small changes stand out.
+func Sparkline(values []float64, width int) string {
+	points := Resample(values, width)
+	if len(points) == 0 {
+		return ""
+	}
+	low, high := bounds(points)
+	var b strings.Builder
+	for _, v := range points {
+		level := 4
+		switch {
+		case math.IsNaN(v):
+			level = 0
+		case high > low:
+			level = 1 + int(math.Round((v-low)/(high-low)*7))
+		case high == 0:
+			level = 1
+		}
+		b.WriteRune(blocks[level])
+	}
+	return b.String()
+}
+
+// Chart renders the values as bars of at most width characters and height lines, scaled from zero to the largest value.
+func Chart(values []float64, width int, height int) string {
+	points := Resample(values, width)
+	if len(points) == 0 || height <= 0 {
+		return ""
+	}
+	_, high := bounds(points)
+	lines := make([]string, height)
+	for row := range lines {
+		// Rows are rendered from the top, the floor is the value at the bottom of the row in eighths
+		floor := (height - row - 1) * 8
+		var b strings.Builder
+		for _, v := range points {
+			eighths := 0
+			if high > 0 && !math.IsNaN(v) && v > 0 {
+				eighths = int(math.Round(v / high * float64(height*8)))
+			}
+			b.WriteRune(blocks[min(8, max(0, eighths-floor))])
+		}
+		lines[row] = b.String()
+	}
+	return strings.Join(lines, "\n")
+}
@@ -126,6 +126,28 @@ func WithNavigation(controls string, view string) string {
 	return strings.Join(lines, "\n")
 }
 
+// WithHelp right-aligns the help on the top border of the view, like the navigation on the bottom border.
+func WithHelp(help string, view string) string {
+	if help == "" {
+		return view
+	}
+
+	padRight := 5
+	helpWidth := lipgloss.Width(help)
+
+	lines := strings.Split(view, "\n")
+
+	if lipgloss.Width(view) >= helpWidth+4 {
+		line := lines[0]
+		lineWidth := lipgloss.Width(line)
+		leftEdge := lineWidth - (helpWidth + padRight)
+		lineLeft := ansi.Truncate(line, leftEdge, "")
+		lineRight := TruncateLeft(line, leftEdge+helpWidth)
+		lines[0] = lineLeft + help + lineRight
+	}
+	return strings.Join(lines, "\n")
+}
+
 // WithOverlay is the merging of two views
 // Based on https://gist.github.com/Broderick-Westrope/b89b14770c09dda928c4a108f437b927
 func WithOverlay(overlay string, view string) string {
@@ -23,3 +23,29 @@ func Test_WithStyles(t *testing.T) {
 		t.Error("Should be empty")
 	}
 }
+
+func Test_Charts(t *testing.T) {
+	points := Resample([]float64{1, 3, 5, 7}, 2)
+	if len(points) != 2 || points[0] != 2 || points[1] != 6 {
+		t.Errorf("Expected averaged points, got %v", points)
+	}
+	if Sparkline(nil, 10) != "" {
+		t.Error("Should be empty")
+	}
+	render := Sparkline([]float64{0, 1, 2, 3, 4, 5, 6, 7}, 10)
+	if render != "▁▂▃▄▅▆▇█" {
+		t.Errorf("Unexpected sparkline %s", render)
+	}
+	render = Sparkline([]float64{0, 0}, 10)
+	if render != "▁▁" {
+		t.Errorf("Unexpected sparkline %s", render)
+	}
+	render = Sparkline([]float64{3, 3}, 10)
+	if render != "▄▄" {
+		t.Errorf("Unexpected sparkline %s", render)
+	}
+	render = Chart([]float64{0, 1, 2, 4}, 4, 2)
+	if render != "   █\n ▄██" {
+		t.Errorf("Unexpected chart\n%s", render)
+	}
+}
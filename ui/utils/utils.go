@@ -4,7 +4,9 @@ import (
 	"encoding/base64"
 	"fmt"
 	"github.com/charmbracelet/log"
+	"math"
 	"strconv"
+	"time"
 )
 
 func toPtr[T any](constVar T) *T { return &constVar }
@@ -60,3 +62,37 @@ func PluralString(singularForm string, valueStr string) string {
 		return singularForm + "s"
 	}
 }
+
+// BitRate converts a given byte rate to a human-readable string format. The output may vary from B/s to GB/s.
+func BitRate(bytes int) string {
+	txString := fmt.Sprintf("%d B/s ", bytes)
+	if bytes >= 1024 {
+		txString = fmt.Sprintf("%d KB/s ", bytes/(1<<10))
+	}
+	if bytes >= int(math.Pow(1024, 2)) {
+		txString = fmt.Sprintf("%d MB/s ", bytes/(1<<20))
+	}
+	if bytes >= int(math.Pow(1024, 3)) {
+		txString = fmt.Sprintf("%d GB/s ", bytes/(1<<30))
+	}
+
+	return txString
+}
+
+// Uptime converts a duration to its two largest units, like 3d4h, 5h12m, 12m5s or 45s.
+func Uptime(d time.Duration) string {
+	d = d.Truncate(time.Second)
+	days := int(d.Hours()) / 24
+	hours := int(d.Hours()) % 24
+	minutes := int(d.Minutes()) % 60
+	seconds := int(d.Seconds()) % 60
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd%dh", days, hours)
+	case hours > 0:
+		return fmt.Sprintf("%dh%dm", hours, minutes)
+	case minutes > 0:
+		return fmt.Sprintf("%dm%ds", minutes, seconds)
+	}
+	return fmt.Sprintf("%ds", seconds)
+}
@@ -1,6 +1,9 @@
 package utils
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func Test_Utils(t *testing.T) {
 	res := UrlEncodeBytesPtrOrNil(nil)
@@ -19,3 +22,16 @@ func Test_Utils(t *testing.T) {
 	}
 
 }
+
+func Test_Uptime(t *testing.T) {
+	for d, want := range map[time.Duration]string{
+		45 * time.Second:                  "45s",
+		12*time.Minute + 5*time.Second:    "12m5s",
+		5*time.Hour + 12*time.Minute + 59: "5h12m",
+		76 * time.Hour:                    "3d4h",
+	} {
+		if got := Uptime(d); got != want {
+			t.Errorf("Uptime(%s) was %s, expected %s", d, got, want)
+		}
+	}
+}
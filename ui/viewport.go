@@ -8,7 +8,10 @@ import (
 	"github.com/algorandfoundation/nodekit/ui/app"
 	"github.com/algorandfoundation/nodekit/ui/overlay"
 	"github.com/algorandfoundation/nodekit/ui/pages/accounts"
+	"github.com/algorandfoundation/nodekit/ui/pages/fleet"
 	"github.com/algorandfoundation/nodekit/ui/pages/keys"
+	"github.com/algorandfoundation/nodekit/ui/pages/metrics"
+	"github.com/algorandfoundation/nodekit/ui/style"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -25,8 +28,10 @@ type ViewportViewModel struct {
 	protocol ProtocolViewModel
 
 	// Pages
+	fleetPage    fleet.ViewModel
 	accountsPage accounts.ViewModel
 	keysPage     keys.ViewModel
+	metricsPage  metrics.ViewModel
 
 	modal overlay.ViewModel
 	page  app.Page
@@ -36,8 +41,10 @@ type ViewportViewModel struct {
 func (m ViewportViewModel) Init() tea.Cmd {
 	return tea.Batch(
 		m.modal.Init(),
+		m.fleetPage.Init(),
 		m.accountsPage.Init(),
 		m.keysPage.Init(),
+		m.metricsPage.Init(),
 	)
 }
 
@@ -47,6 +54,12 @@ func (m ViewportViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmd  tea.Cmd
 		cmds []tea.Cmd
 	)
+	// Only the selected node drives the header, pages and modals
+	if state, ok := msg.(*algod.StateModel); ok && state != m.Data {
+		m.fleetPage, cmd = m.fleetPage.HandleMessage(state)
+		return m, cmd
+	}
+
 	// Handle Header and Modal Updates
 	m.protocol, cmd = m.protocol.HandleMessage(msg)
 	cmds = append(cmds, cmd)
@@ -60,6 +73,15 @@ func (m ViewportViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case app.Page:
 		m.page = msg
 		return m, nil
+	// Drill into the accounts of a node from the fleet page
+	case app.NodeSelected:
+		return m.selectNode(msg.State)
+	// Errors of the other nodes are shown on the fleet page by their state
+	case app.NodeError:
+		if msg.State == m.Data {
+			m.modal, cmd = m.modal.HandleMessage(msg.Err)
+		}
+		return m, cmd
 	// When the Participation Key endpoint responds, check for keys remaining
 	// and navigate back to accounts when te participation key list is empty.
 	case app.DeleteFinished:
@@ -80,6 +102,10 @@ func (m ViewportViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "p":
 			return m, app.EmitShowModal(app.HybridModal)
 		case "g":
+			// The fleet page has no account to generate for
+			if m.page == app.FleetPage {
+				break
+			}
 			// Only open modal when it is closed and not syncing
 			if m.Data.Status.State == algod.StableState && m.Data.Metrics.RoundTime > 0 {
 				return m, tea.Sequence(
@@ -92,16 +118,45 @@ func (m ViewportViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				cmds = append(cmds, cmd)
 				return m, tea.Batch(cmds...)
 			}
+		case "i":
+			if m.page == app.FleetPage {
+				break
+			}
+			// Only import when the node can validate the key against the current round
+			if m.Data.Status.State == algod.StableState {
+				return m, app.EmitShowModal(app.ImportModal)
+			}
+			importErr := errors.New("Please wait until your node is fully synced")
+			m.modal, cmd = m.modal.HandleMessage(importErr)
+			cmds = append(cmds, cmd)
+			return m, tea.Batch(cmds...)
+		case "m":
+			if m.page == app.FleetPage {
+				break
+			}
+			// Toggle the metrics history
+			if m.page == app.MetricsPage {
+				return m, app.EmitShowPage(app.AccountsPage)
+			}
+			return m, app.EmitShowPage(app.MetricsPage)
 		case "left":
+			// Go back to the fleet overview
+			if m.page == app.AccountsPage && m.IsFleet() {
+				return m, app.EmitShowPage(app.FleetPage)
+			}
 			// No more pages to the left
-			if m.page == app.AccountsPage {
+			if m.page == app.AccountsPage || m.page == app.FleetPage {
 				return m, nil
 			}
-			// Navigate to the Keys Page
-			if m.page == app.KeysPage {
+			// Navigate to the Accounts Page
+			if m.page == app.KeysPage || m.page == app.MetricsPage {
 				return m, app.EmitShowPage(app.AccountsPage)
 			}
 		case "right":
+			// The fleet page selects the node
+			if m.page == app.FleetPage {
+				break
+			}
 			// No more pages to the right
 			if m.page != app.AccountsPage {
 				return m, nil
@@ -123,6 +178,10 @@ func (m ViewportViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		// Pass commands to the pages, depending on which is active
+		if m.page == app.FleetPage {
+			m.fleetPage, cmd = m.fleetPage.HandleMessage(msg)
+			cmds = append(cmds, cmd)
+		}
 		if m.page == app.AccountsPage {
 			m.accountsPage, cmd = m.accountsPage.HandleMessage(msg)
 			cmds = append(cmds, cmd)
@@ -131,6 +190,10 @@ func (m ViewportViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.keysPage, cmd = m.keysPage.HandleMessage(msg)
 			cmds = append(cmds, cmd)
 		}
+		if m.page == app.MetricsPage {
+			m.metricsPage, cmd = m.metricsPage.HandleMessage(msg)
+			cmds = append(cmds, cmd)
+		}
 
 		return m, tea.Batch(cmds...)
 
@@ -152,21 +215,31 @@ func (m ViewportViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		// Handle the page resize event
+		m.fleetPage, cmd = m.fleetPage.HandleMessage(pageMsg)
+		cmds = append(cmds, cmd)
+
 		m.accountsPage, cmd = m.accountsPage.HandleMessage(pageMsg)
 		cmds = append(cmds, cmd)
 
 		m.keysPage, cmd = m.keysPage.HandleMessage(pageMsg)
 		cmds = append(cmds, cmd)
 
+		m.metricsPage, cmd = m.metricsPage.HandleMessage(pageMsg)
+		cmds = append(cmds, cmd)
+
 		// Avoid triggering commands again
 		return m, tea.Batch(cmds...)
 	}
 
 	// Handle all other events
+	m.fleetPage, cmd = m.fleetPage.HandleMessage(msg)
+	cmds = append(cmds, cmd)
 	m.accountsPage, cmd = m.accountsPage.HandleMessage(msg)
 	cmds = append(cmds, cmd)
 	m.keysPage, cmd = m.keysPage.HandleMessage(msg)
 	cmds = append(cmds, cmd)
+	m.metricsPage, cmd = m.metricsPage.HandleMessage(msg)
+	cmds = append(cmds, cmd)
 	m.modal, cmd = m.modal.HandleMessage(msg)
 	cmds = append(cmds, cmd)
 
@@ -179,10 +252,14 @@ func (m ViewportViewModel) View() string {
 	// Handle Page render
 	var page tea.Model
 	switch m.page {
+	case app.FleetPage:
+		page = m.fleetPage
 	case app.AccountsPage:
 		page = m.accountsPage
 	case app.KeysPage:
 		page = m.keysPage
+	case app.MetricsPage:
+		page = m.metricsPage
 	}
 
 	if page == nil {
@@ -219,8 +296,10 @@ func NewViewportViewModel(state *algod.StateModel) (*ViewportViewModel, error) {
 		protocol: MakeProtocolViewModel(state),
 
 		// Pages
+		fleetPage:    fleet.New(nil),
 		accountsPage: accounts.New(state),
 		keysPage:     keys.New("", state.ParticipationKeys),
+		metricsPage:  metrics.New(state),
 
 		// Modal
 		modal: overlay.New("", false, state),
@@ -230,3 +309,38 @@ func NewViewportViewModel(state *algod.StateModel) (*ViewportViewModel, error) {
 
 	return &m, nil
 }
+
+// IsFleet checks if the session watches more than one node.
+func (m ViewportViewModel) IsFleet() bool {
+	return len(m.fleetPage.Nodes) > 1
+}
+
+// selectNode rebuilds the header, pages and modals for the state of a fleet node and shows its accounts.
+func (m ViewportViewModel) selectNode(state *algod.StateModel) (tea.Model, tea.Cmd) {
+	next, _ := NewViewportViewModel(state)
+	next.fleetPage = m.fleetPage
+	next.setFleetNavigation()
+	model, cmd := next.Update(tea.WindowSizeMsg{Width: m.TerminalWidth, Height: m.TerminalHeight})
+	return model, tea.Batch(next.Init(), cmd, app.EmitShowPage(app.AccountsPage))
+}
+
+// setFleetNavigation adds the fleet page to the navigation of the accounts and keys pages.
+func (m *ViewportViewModel) setFleetNavigation() {
+	m.accountsPage.Navigation = "| <- -> | fleet | " + style.Green.Render("accounts") + " | keys |"
+	m.keysPage.Navigation = "| <- | fleet | accounts | " + style.Green.Render("keys") + " |"
+}
+
+// NewFleetViewportViewModel handles the construction of the TUI viewport for several nodes, starting on the fleet page.
+func NewFleetViewportViewModel(nodes []fleet.Node) (*ViewportViewModel, error) {
+	if len(nodes) == 0 {
+		return nil, errors.New("no nodes in the fleet")
+	}
+	m, err := NewViewportViewModel(nodes[0].State)
+	if err != nil {
+		return nil, err
+	}
+	m.fleetPage = fleet.New(nodes)
+	m.setFleetNavigation()
+	m.page = app.FleetPage
+	return m, nil
+}
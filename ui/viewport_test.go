@@ -2,12 +2,16 @@ package ui
 
 import (
 	"bytes"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/algorandfoundation/nodekit/internal/algod"
 	"github.com/algorandfoundation/nodekit/internal/test"
 	"github.com/algorandfoundation/nodekit/ui/app"
 	uitest "github.com/algorandfoundation/nodekit/ui/internal/test"
+	"github.com/algorandfoundation/nodekit/ui/pages/fleet"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/x/exp/teatest"
 )
@@ -63,6 +67,16 @@ func Test_ViewportViewRender(t *testing.T) {
 		Type:  tea.KeyRunes,
 		Runes: []rune("left"),
 	})
+
+	// Open and close the metrics page
+	tm.Send(tea.KeyMsg{
+		Type:  tea.KeyRunes,
+		Runes: []rune("m"),
+	})
+	tm.Send(tea.KeyMsg{
+		Type:  tea.KeyRunes,
+		Runes: []rune("m"),
+	})
 	// Send quit key
 	tm.Send(tea.KeyMsg{
 		Type:  tea.KeyRunes,
@@ -71,3 +85,47 @@ func Test_ViewportViewRender(t *testing.T) {
 
 	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
 }
+
+func Test_FleetViewport(t *testing.T) {
+	mainnet := uitest.GetState(test.GetClient(false))
+	testnet := uitest.GetState(test.GetClient(false))
+	m, err := NewFleetViewportViewModel([]fleet.Node{
+		{Name: "mainnet", State: mainnet},
+		{Name: "testnet", State: testnet},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.page != app.FleetPage || !m.IsFleet() {
+		t.Fatal("Expected to start on the fleet page")
+	}
+
+	// Other nodes do not replace the selected state
+	model, _ := m.Update(testnet)
+	if model.(ViewportViewModel).Data != mainnet {
+		t.Error("Expected the selected node to be kept")
+	}
+
+	model, cmd := model.Update(app.NodeSelected{Name: "testnet", State: testnet})
+	if model.(ViewportViewModel).Data != testnet || cmd == nil {
+		t.Error("Expected the testnet node to be selected")
+	}
+
+	_, err = NewFleetViewportViewModel(nil)
+	if err == nil {
+		t.Error("Expected an error for an empty fleet")
+	}
+
+	// The fleet starts when the first node could not be watched
+	m, err = NewFleetViewportViewModel([]fleet.Node{
+		{Name: "down", State: &algod.StateModel{Status: algod.Status{State: algod.DownState}}, Err: errors.New("connection refused")},
+		{Name: "testnet", State: testnet},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	model, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	if !strings.Contains(model.View(), "down: connection refused") {
+		t.Error("Expected the error of the node to be shown")
+	}
+}